@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AppParameters are the configurable fields of an App.
+type AppParameters struct {
+	// ClusterID is the Rancher ID of the cluster the chart is installed into.
+	ClusterID string `json:"clusterId,omitempty"`
+	// ClusterIDRef is the name of the cluster, e.g. the name of a RKE1Cluster.
+	// It is resolved to ClusterID.
+	ClusterIDRef string `json:"clusterIdRef,omitempty"`
+
+	// RepoName is the name of the catalog repository of the cluster holding
+	// the chart, e.g. rancher-charts.
+	RepoName string `json:"repoName"`
+	// Chart is the name of the chart, e.g. rancher-monitoring.
+	Chart string `json:"chart"`
+	// Version of the chart.
+	Version string `json:"version"`
+	// Namespace the chart is installed into.
+	Namespace string `json:"namespace"`
+	// ReleaseName is the name of the Helm release. Defaults to the name of
+	// the managed resource.
+	ReleaseName string `json:"releaseName,omitempty"`
+	// Values of the chart.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Values *runtime.RawExtension `json:"values,omitempty"`
+	// Wait until all resources of the release are ready before the
+	// installation or upgrade is marked as successful.
+	Wait bool `json:"wait,omitempty"`
+	// Timeout of the installation, upgrade or uninstallation. Defaults to
+	// ten minutes.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// AppObservation are the observable fields of an App.
+type AppObservation struct {
+	ClusterID string `json:"clusterId,omitempty"`
+	// Status of the Helm release, e.g. deployed, failed or pending-upgrade.
+	Status string `json:"status,omitempty"`
+	// Revision of the Helm release.
+	Revision int64 `json:"revision,omitempty"`
+	// Version of the installed chart.
+	Version string `json:"version,omitempty"`
+}
+
+// An AppSpec defines the desired state of an App.
+type AppSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AppParameters `json:"forProvider"`
+}
+
+// An AppStatus represents the observed state of an App.
+type AppStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AppObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An App is a Helm chart of the Rancher catalog installed into a cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CHART",type="string",JSONPath=".spec.forProvider.chart"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="REVISION",type="integer",JSONPath=".status.atProvider.revision"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type App struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppSpec   `json:"spec"`
+	Status AppStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppList contains a list of App
+type AppList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []App `json:"items"`
+}
+
+// App type metadata.
+var (
+	AppKind             = reflect.TypeOf(App{}).Name()
+	AppGroupKind        = schema.GroupKind{Group: Group, Kind: AppKind}.String()
+	AppKindAPIVersion   = AppKind + "." + SchemeGroupVersion.String()
+	AppGroupVersionKind = SchemeGroupVersion.WithKind(AppKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&App{}, &AppList{})
+}
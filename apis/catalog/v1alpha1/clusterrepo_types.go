@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ClusterRepoSecretReference references a Secret of the downstream cluster.
+type ClusterRepoSecretReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ClusterRepoParameters are the configurable fields of a ClusterRepo.
+type ClusterRepoParameters struct {
+	// ClusterID is the Rancher ID of the cluster the repository is added to.
+	// Defaults to local, the cluster Rancher runs in.
+	ClusterID string `json:"clusterId,omitempty"`
+	// ClusterIDRef is the name of the cluster, e.g. the name of a RKE1Cluster.
+	// It is resolved to ClusterID.
+	ClusterIDRef string `json:"clusterIdRef,omitempty"`
+
+	// URL of a Helm HTTP repository. Either URL or GitRepo must be set.
+	URL string `json:"url,omitempty"`
+	// GitRepo is the URL of a git repository holding charts.
+	GitRepo string `json:"gitRepo,omitempty"`
+	// GitBranch of GitRepo. Defaults to master.
+	GitBranch string `json:"gitBranch,omitempty"`
+	// ClientSecret references a Secret in the cattle-system namespace of the
+	// cluster holding credentials for the repository.
+	ClientSecret *ClusterRepoSecretReference `json:"clientSecret,omitempty"`
+	// CABundle is the PEM encoded CA certificate of the repository.
+	CABundle string `json:"caBundle,omitempty"`
+	// InsecureSkipTLSVerify disables verification of the repository
+	// certificate.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// ClusterRepoObservation are the observable fields of a ClusterRepo.
+type ClusterRepoObservation struct {
+	ClusterID string `json:"clusterId,omitempty"`
+	// Commit is the git commit the charts were last read from.
+	Commit string `json:"commit,omitempty"`
+	// DownloadTime is when the repository index was last downloaded.
+	DownloadTime *metav1.Time `json:"downloadTime,omitempty"`
+}
+
+// A ClusterRepoSpec defines the desired state of a ClusterRepo.
+type ClusterRepoSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterRepoParameters `json:"forProvider"`
+}
+
+// A ClusterRepoStatus represents the observed state of a ClusterRepo.
+type ClusterRepoStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterRepoObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterRepo is a Helm chart repository of the Rancher catalog of a
+// cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.url"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher},path=clusterrepos
+type ClusterRepo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterRepoSpec   `json:"spec"`
+	Status ClusterRepoStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterRepoList contains a list of ClusterRepo
+type ClusterRepoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterRepo `json:"items"`
+}
+
+// ClusterRepo type metadata.
+var (
+	ClusterRepoKind             = reflect.TypeOf(ClusterRepo{}).Name()
+	ClusterRepoGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterRepoKind}.String()
+	ClusterRepoKindAPIVersion   = ClusterRepoKind + "." + SchemeGroupVersion.String()
+	ClusterRepoGroupVersionKind = SchemeGroupVersion.WithKind(ClusterRepoKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ClusterRepo{}, &ClusterRepoList{})
+}
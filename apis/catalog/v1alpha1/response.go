@@ -0,0 +1,120 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SteveMetadata is the object metadata returned by the Rancher v1 API.
+type SteveMetadata struct {
+	Name            string      `json:"name"`
+	Namespace       string      `json:"namespace,omitempty"`
+	ResourceVersion string      `json:"resourceVersion,omitempty"`
+	State           *SteveState `json:"state,omitempty"`
+}
+
+// SteveState is the summarised state of an object of the Rancher v1 API.
+type SteveState struct {
+	Name          string `json:"name,omitempty"`
+	Error         bool   `json:"error,omitempty"`
+	Transitioning bool   `json:"transitioning,omitempty"`
+	Message       string `json:"message,omitempty"`
+}
+
+// SteveCondition is a condition of an object of the Rancher v1 API.
+type SteveCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterRepoData is a catalog.cattle.io ClusterRepo.
+type ClusterRepoData struct {
+	Type     string                 `json:"type,omitempty"`
+	Metadata SteveMetadata          `json:"metadata"`
+	Spec     ClusterRepoSpecData    `json:"spec"`
+	Status   *ClusterRepoStatusData `json:"status,omitempty"`
+}
+
+type ClusterRepoSpecData struct {
+	URL                   string                      `json:"url,omitempty"`
+	GitRepo               string                      `json:"gitRepo,omitempty"`
+	GitBranch             string                      `json:"gitBranch,omitempty"`
+	ClientSecret          *ClusterRepoSecretReference `json:"clientSecret,omitempty"`
+	CABundle              []byte                      `json:"caBundle,omitempty"`
+	InsecureSkipTLSVerify bool                        `json:"insecureSkipTLSVerify"`
+}
+
+type ClusterRepoStatusData struct {
+	Commit       string           `json:"commit,omitempty"`
+	DownloadTime *metav1.Time     `json:"downloadTime,omitempty"`
+	Conditions   []SteveCondition `json:"conditions,omitempty"`
+}
+
+// AppData is a catalog.cattle.io App, i.e. an installed Helm release.
+type AppData struct {
+	Metadata SteveMetadata `json:"metadata"`
+	Spec     AppSpecData   `json:"spec"`
+}
+
+type AppSpecData struct {
+	Name      string          `json:"name"`
+	Namespace string          `json:"namespace"`
+	Version   int64           `json:"version"`
+	Chart     *AppChart       `json:"chart,omitempty"`
+	Info      *AppInfo        `json:"info,omitempty"`
+	Values    json.RawMessage `json:"values,omitempty"`
+}
+
+type AppChart struct {
+	Metadata AppChartMetadata `json:"metadata"`
+}
+
+type AppChartMetadata struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type AppInfo struct {
+	Status      string `json:"status,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ChartInstallAction is the input of the install action of a ClusterRepo.
+type ChartInstallAction struct {
+	Namespace string           `json:"namespace"`
+	Wait      bool             `json:"wait"`
+	Timeout   *metav1.Duration `json:"timeout,omitempty"`
+	Charts    []ChartInstall   `json:"charts"`
+}
+
+type ChartInstall struct {
+	ChartName   string          `json:"chartName"`
+	Version     string          `json:"version"`
+	ReleaseName string          `json:"releaseName"`
+	Values      json.RawMessage `json:"values,omitempty"`
+}
+
+// ChartUpgradeAction is the input of the upgrade action of a ClusterRepo.
+type ChartUpgradeAction struct {
+	Namespace string           `json:"namespace"`
+	Wait      bool             `json:"wait"`
+	Timeout   *metav1.Duration `json:"timeout,omitempty"`
+	Charts    []ChartUpgrade   `json:"charts"`
+}
+
+type ChartUpgrade struct {
+	ChartName   string          `json:"chartName"`
+	Version     string          `json:"version"`
+	ReleaseName string          `json:"releaseName"`
+	Values      json.RawMessage `json:"values,omitempty"`
+	// ResetValues replaces the values of the release instead of merging
+	// them.
+	ResetValues bool `json:"resetValues"`
+}
+
+// ChartUninstallAction is the input of the uninstall action of an App.
+type ChartUninstallAction struct {
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FleetClusterGroupParameters are the configurable fields of a FleetClusterGroup.
+type FleetClusterGroupParameters struct {
+	// Namespace is the Fleet workspace. Defaults to fleet-default.
+	Namespace string `json:"namespace,omitempty"`
+	// Selector selects the clusters of the group. An empty selector selects
+	// every cluster of the workspace.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// FleetClusterGroupObservation are the observable fields of a FleetClusterGroup.
+type FleetClusterGroupObservation struct {
+	ClusterCount         int `json:"clusterCount,omitempty"`
+	NonReadyClusterCount int `json:"nonReadyClusterCount,omitempty"`
+	// Summary counts the bundle deployments of the group by state.
+	Summary BundleSummary `json:"summary,omitempty"`
+}
+
+// A FleetClusterGroupSpec defines the desired state of a FleetClusterGroup.
+type FleetClusterGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FleetClusterGroupParameters `json:"forProvider"`
+}
+
+// A FleetClusterGroupStatus represents the observed state of a FleetClusterGroup.
+type FleetClusterGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FleetClusterGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FleetClusterGroup is a Fleet ClusterGroup selecting clusters by label.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTERS",type="integer",JSONPath=".status.atProvider.clusterCount"
+// +kubebuilder:printcolumn:name="NOT-READY",type="integer",JSONPath=".status.atProvider.nonReadyClusterCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type FleetClusterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FleetClusterGroupSpec   `json:"spec"`
+	Status FleetClusterGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FleetClusterGroupList contains a list of FleetClusterGroup
+type FleetClusterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FleetClusterGroup `json:"items"`
+}
+
+// FleetClusterGroup type metadata.
+var (
+	FleetClusterGroupKind             = reflect.TypeOf(FleetClusterGroup{}).Name()
+	FleetClusterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: FleetClusterGroupKind}.String()
+	FleetClusterGroupKindAPIVersion   = FleetClusterGroupKind + "." + SchemeGroupVersion.String()
+	FleetClusterGroupGroupVersionKind = SchemeGroupVersion.WithKind(FleetClusterGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FleetClusterGroup{}, &FleetClusterGroupList{})
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GitRepoConfig are the settings of a Fleet GitRepo.
+type GitRepoConfig struct {
+	// Repo is the URL of the git repository.
+	Repo string `json:"repo"`
+	// Branch to watch. Defaults to master.
+	Branch string `json:"branch,omitempty"`
+	// Revision is a commit or tag to deploy instead of a branch.
+	Revision string `json:"revision,omitempty"`
+	// Paths of the repository holding bundles. Defaults to the root.
+	Paths []string `json:"paths,omitempty"`
+	// ClientSecretName is an existing Secret of the workspace holding the
+	// credentials of the git repository. Overridden by ClientSecretRef.
+	ClientSecretName string `json:"clientSecretName,omitempty"`
+	// HelmSecretName is an existing Secret of the workspace holding the
+	// credentials of Helm repositories. Overridden by HelmSecretRef.
+	HelmSecretName        string `json:"helmSecretName,omitempty"`
+	InsecureSkipTLSVerify *bool  `json:"insecureSkipTLSVerify,omitempty"`
+	// Targets select the clusters the bundles are deployed to.
+	Targets []GitTarget `json:"targets,omitempty"`
+	// TargetNamespace forces all resources into a single namespace.
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+	// ServiceAccount used to deploy the bundles in downstream clusters.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// Paused stops changes of the repository from being deployed.
+	Paused *bool `json:"paused,omitempty"`
+	// PollingInterval is how often the repository is checked for changes.
+	PollingInterval *metav1.Duration `json:"pollingInterval,omitempty"`
+	// ForceSyncGeneration redeploys the bundles whenever it is increased.
+	ForceSyncGeneration int64 `json:"forceSyncGeneration,omitempty"`
+}
+
+// A GitTarget selects clusters to deploy to. All set fields must match.
+type GitTarget struct {
+	Name                 string                `json:"name,omitempty"`
+	ClusterName          string                `json:"clusterName,omitempty"`
+	ClusterSelector      *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+	ClusterGroup         string                `json:"clusterGroup,omitempty"`
+	ClusterGroupSelector *metav1.LabelSelector `json:"clusterGroupSelector,omitempty"`
+}
+
+// BundleSummary counts bundle deployments by state.
+type BundleSummary struct {
+	DesiredReady int `json:"desiredReady"`
+	Ready        int `json:"ready"`
+	NotReady     int `json:"notReady,omitempty"`
+	Pending      int `json:"pending,omitempty"`
+	WaitApplied  int `json:"waitApplied,omitempty"`
+	ErrApplied   int `json:"errApplied,omitempty"`
+	OutOfSync    int `json:"outOfSync,omitempty"`
+	Modified     int `json:"modified,omitempty"`
+}
+
+// FleetGitRepoParameters are the configurable fields of a FleetGitRepo.
+type FleetGitRepoParameters struct {
+	// Namespace is the Fleet workspace. Defaults to fleet-default.
+	Namespace     string `json:"namespace,omitempty"`
+	GitRepoConfig `json:",inline"`
+	// ClientSecretRef references a Secret holding the credentials of the git
+	// repository, e.g. username and password or ssh-privatekey. It is copied
+	// into the workspace and used as clientSecretName.
+	ClientSecretRef *xpv1.SecretReference `json:"clientSecretRef,omitempty"`
+	// HelmSecretRef references a Secret holding the username and password of
+	// the Helm repositories used by the bundles. It is copied into the
+	// workspace and used as helmSecretName.
+	HelmSecretRef *xpv1.SecretReference `json:"helmSecretRef,omitempty"`
+}
+
+// FleetGitRepoObservation are the observable fields of a FleetGitRepo.
+type FleetGitRepoObservation struct {
+	// Commit is the git commit currently deployed.
+	Commit string `json:"commit,omitempty"`
+	// ReadyClusters is the number of clusters the bundles are ready on.
+	ReadyClusters int `json:"readyClusters,omitempty"`
+	// DesiredReadyClusters is the number of clusters targeted.
+	DesiredReadyClusters int `json:"desiredReadyClusters,omitempty"`
+	// Summary counts the bundle deployments by state.
+	Summary BundleSummary `json:"summary,omitempty"`
+	// SecretVersion is the resource version of the referenced Secrets that
+	// were last copied into the workspace.
+	SecretVersion string `json:"secretVersion,omitempty"`
+}
+
+// A FleetGitRepoSpec defines the desired state of a FleetGitRepo.
+type FleetGitRepoSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FleetGitRepoParameters `json:"forProvider"`
+}
+
+// A FleetGitRepoStatus represents the observed state of a FleetGitRepo.
+type FleetGitRepoStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FleetGitRepoObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FleetGitRepo is a Fleet GitRepo deploying the bundles of a git repository
+// to downstream clusters.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REPO",type="string",JSONPath=".spec.forProvider.repo"
+// +kubebuilder:printcolumn:name="CLUSTERS-READY",type="integer",JSONPath=".status.atProvider.readyClusters"
+// +kubebuilder:printcolumn:name="DESIRED",type="integer",JSONPath=".status.atProvider.desiredReadyClusters"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher},path=fleetgitrepos
+type FleetGitRepo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FleetGitRepoSpec   `json:"spec"`
+	Status FleetGitRepoStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FleetGitRepoList contains a list of FleetGitRepo
+type FleetGitRepoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FleetGitRepo `json:"items"`
+}
+
+// FleetGitRepo type metadata.
+var (
+	FleetGitRepoKind             = reflect.TypeOf(FleetGitRepo{}).Name()
+	FleetGitRepoGroupKind        = schema.GroupKind{Group: Group, Kind: FleetGitRepoKind}.String()
+	FleetGitRepoKindAPIVersion   = FleetGitRepoKind + "." + SchemeGroupVersion.String()
+	FleetGitRepoGroupVersionKind = SchemeGroupVersion.WithKind(FleetGitRepoKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FleetGitRepo{}, &FleetGitRepoList{})
+}
@@ -0,0 +1,53 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SteveMetadata is the object metadata returned by the Rancher v1 API.
+type SteveMetadata struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace,omitempty"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// GitRepoData is a fleet.cattle.io GitRepo.
+type GitRepoData struct {
+	Type     string             `json:"type,omitempty"`
+	Metadata SteveMetadata      `json:"metadata"`
+	Spec     GitRepoConfig      `json:"spec"`
+	Status   *GitRepoStatusData `json:"status,omitempty"`
+}
+
+type GitRepoStatusData struct {
+	Commit               string        `json:"commit,omitempty"`
+	ReadyClusters        int           `json:"readyClusters"`
+	DesiredReadyClusters int           `json:"desiredReadyClusters"`
+	Summary              BundleSummary `json:"summary"`
+}
+
+// ClusterGroupData is a fleet.cattle.io ClusterGroup.
+type ClusterGroupData struct {
+	Type     string                  `json:"type,omitempty"`
+	Metadata SteveMetadata           `json:"metadata"`
+	Spec     ClusterGroupSpecData    `json:"spec"`
+	Status   *ClusterGroupStatusData `json:"status,omitempty"`
+}
+
+type ClusterGroupSpecData struct {
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+type ClusterGroupStatusData struct {
+	ClusterCount         int           `json:"clusterCount"`
+	NonReadyClusterCount int           `json:"nonReadyClusterCount"`
+	Summary              BundleSummary `json:"summary"`
+}
+
+// SecretData is a Kubernetes Secret of the Rancher v1 API.
+type SecretData struct {
+	Type       string            `json:"type,omitempty"`
+	Metadata   SteveMetadata     `json:"metadata"`
+	SecretType string            `json:"_type,omitempty"`
+	Data       map[string][]byte `json:"data,omitempty"`
+}
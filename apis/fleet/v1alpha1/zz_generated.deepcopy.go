@@ -0,0 +1,516 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BundleSummary) DeepCopyInto(out *BundleSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleSummary.
+func (in *BundleSummary) DeepCopy() *BundleSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(BundleSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGroupData) DeepCopyInto(out *ClusterGroupData) {
+	*out = *in
+	out.Metadata = in.Metadata
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ClusterGroupStatusData)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupData.
+func (in *ClusterGroupData) DeepCopy() *ClusterGroupData {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterGroupData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGroupSpecData) DeepCopyInto(out *ClusterGroupSpecData) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupSpecData.
+func (in *ClusterGroupSpecData) DeepCopy() *ClusterGroupSpecData {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterGroupSpecData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGroupStatusData) DeepCopyInto(out *ClusterGroupStatusData) {
+	*out = *in
+	out.Summary = in.Summary
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupStatusData.
+func (in *ClusterGroupStatusData) DeepCopy() *ClusterGroupStatusData {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterGroupStatusData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetClusterGroup) DeepCopyInto(out *FleetClusterGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetClusterGroup.
+func (in *FleetClusterGroup) DeepCopy() *FleetClusterGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetClusterGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetClusterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetClusterGroupList) DeepCopyInto(out *FleetClusterGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FleetClusterGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetClusterGroupList.
+func (in *FleetClusterGroupList) DeepCopy() *FleetClusterGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetClusterGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetClusterGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetClusterGroupObservation) DeepCopyInto(out *FleetClusterGroupObservation) {
+	*out = *in
+	out.Summary = in.Summary
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetClusterGroupObservation.
+func (in *FleetClusterGroupObservation) DeepCopy() *FleetClusterGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetClusterGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetClusterGroupParameters) DeepCopyInto(out *FleetClusterGroupParameters) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetClusterGroupParameters.
+func (in *FleetClusterGroupParameters) DeepCopy() *FleetClusterGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetClusterGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetClusterGroupSpec) DeepCopyInto(out *FleetClusterGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetClusterGroupSpec.
+func (in *FleetClusterGroupSpec) DeepCopy() *FleetClusterGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetClusterGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetClusterGroupStatus) DeepCopyInto(out *FleetClusterGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetClusterGroupStatus.
+func (in *FleetClusterGroupStatus) DeepCopy() *FleetClusterGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetClusterGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetGitRepo) DeepCopyInto(out *FleetGitRepo) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetGitRepo.
+func (in *FleetGitRepo) DeepCopy() *FleetGitRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetGitRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetGitRepo) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetGitRepoList) DeepCopyInto(out *FleetGitRepoList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FleetGitRepo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetGitRepoList.
+func (in *FleetGitRepoList) DeepCopy() *FleetGitRepoList {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetGitRepoList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetGitRepoList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetGitRepoObservation) DeepCopyInto(out *FleetGitRepoObservation) {
+	*out = *in
+	out.Summary = in.Summary
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetGitRepoObservation.
+func (in *FleetGitRepoObservation) DeepCopy() *FleetGitRepoObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetGitRepoObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetGitRepoParameters) DeepCopyInto(out *FleetGitRepoParameters) {
+	*out = *in
+	in.GitRepoConfig.DeepCopyInto(&out.GitRepoConfig)
+	if in.ClientSecretRef != nil {
+		in, out := &in.ClientSecretRef, &out.ClientSecretRef
+		*out = new(commonv1.SecretReference)
+		**out = **in
+	}
+	if in.HelmSecretRef != nil {
+		in, out := &in.HelmSecretRef, &out.HelmSecretRef
+		*out = new(commonv1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetGitRepoParameters.
+func (in *FleetGitRepoParameters) DeepCopy() *FleetGitRepoParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetGitRepoParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetGitRepoSpec) DeepCopyInto(out *FleetGitRepoSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetGitRepoSpec.
+func (in *FleetGitRepoSpec) DeepCopy() *FleetGitRepoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetGitRepoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetGitRepoStatus) DeepCopyInto(out *FleetGitRepoStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetGitRepoStatus.
+func (in *FleetGitRepoStatus) DeepCopy() *FleetGitRepoStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetGitRepoStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRepoConfig) DeepCopyInto(out *GitRepoConfig) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InsecureSkipTLSVerify != nil {
+		in, out := &in.InsecureSkipTLSVerify, &out.InsecureSkipTLSVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]GitTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepoConfig.
+func (in *GitRepoConfig) DeepCopy() *GitRepoConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRepoConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRepoData) DeepCopyInto(out *GitRepoData) {
+	*out = *in
+	out.Metadata = in.Metadata
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(GitRepoStatusData)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepoData.
+func (in *GitRepoData) DeepCopy() *GitRepoData {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRepoData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRepoStatusData) DeepCopyInto(out *GitRepoStatusData) {
+	*out = *in
+	out.Summary = in.Summary
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepoStatusData.
+func (in *GitRepoStatusData) DeepCopy() *GitRepoStatusData {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRepoStatusData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitTarget) DeepCopyInto(out *GitTarget) {
+	*out = *in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterGroupSelector != nil {
+		in, out := &in.ClusterGroupSelector, &out.ClusterGroupSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitTarget.
+func (in *GitTarget) DeepCopy() *GitTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(GitTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretData) DeepCopyInto(out *SecretData) {
+	*out = *in
+	out.Metadata = in.Metadata
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string][]byte, len(*in))
+		for key, val := range *in {
+			var outVal []byte
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]byte, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretData.
+func (in *SecretData) DeepCopy() *SecretData {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SteveMetadata) DeepCopyInto(out *SteveMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SteveMetadata.
+func (in *SteveMetadata) DeepCopy() *SteveMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(SteveMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
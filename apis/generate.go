@@ -20,12 +20,21 @@ limitations under the License.
 // NOTE: See the below link for details on what is happening here.
 // https://github.com/golang/go/wiki/Modules#how-can-i-track-tool-dependencies-for-a-module
 
-// Remove existing CRDs
-//go:generate rm -rf ../package/crds
+// Remove existing CRDs and webhook configurations
+//go:generate rm -rf ../package/crds ../package/webhookconfigurations
 
 // Generate deepcopy methodsets and CRD manifests
 //go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen object:headerFile=../hack/boilerplate.go.txt paths=./... crd:crdVersions=v1 output:artifacts:config=../package/crds
 
+// Convert between the versions of multi-version CRDs with the conversion webhook
+//go:generate go run -tags generate ../hack/crdconversion ../package/crds/rke1.rancher.crossplane.io_rke1clusters.yaml ../package/crds/rke1.rancher.crossplane.io_rke1nodetemplates.yaml
+
+// Generate webhook configurations
+//go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen webhook paths=./... output:artifacts:config=../package/webhookconfigurations
+
+// Point the webhook configurations at the webhook service of the provider
+//go:generate go run -tags generate ../hack/crdconversion ../package/webhookconfigurations/manifests.yaml
+
 // Generate crossplane-runtime methodsets (resource.Claim, etc)
 //go:generate go run -tags generate github.com/crossplane/crossplane-tools/cmd/angryjet generate-methodsets --header-file=../hack/boilerplate.go.txt ./...
 
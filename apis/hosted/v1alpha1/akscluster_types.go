@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AKSClusterConfigSpec is the aksConfig block of a Rancher cluster.
+type AKSClusterConfigSpec struct {
+	// AzureCredentialSecret is the ID of the Rancher cloud credential used to
+	// provision the cluster, e.g. cattle-global-data:cc-xxxxx. It is resolved
+	// from cloudCredentialRef when not set.
+	// +optional
+	AzureCredentialSecret       string            `json:"azureCredentialSecret,omitempty"`
+	ClusterName                 string            `json:"clusterName,omitempty"`
+	ResourceGroup               string            `json:"resourceGroup"`
+	ResourceLocation            string            `json:"resourceLocation"`
+	Imported                    bool              `json:"imported,omitempty"`
+	Tags                        map[string]string `json:"tags,omitempty"`
+	KubernetesVersion           *string           `json:"kubernetesVersion,omitempty"`
+	DNSPrefix                   *string           `json:"dnsPrefix,omitempty"`
+	NodeResourceGroup           *string           `json:"nodeResourceGroup,omitempty"`
+	PrivateCluster              *bool             `json:"privateCluster,omitempty"`
+	AuthorizedIPRanges          []string          `json:"authorizedIpRanges,omitempty"`
+	NetworkPlugin               *string           `json:"networkPlugin,omitempty"`
+	NetworkPolicy               *string           `json:"networkPolicy,omitempty"`
+	NetworkDNSServiceIP         *string           `json:"dnsServiceIp,omitempty"`
+	NetworkDockerBridgeCIDR     *string           `json:"dockerBridgeCidr,omitempty"`
+	NetworkServiceCIDR          *string           `json:"serviceCidr,omitempty"`
+	NetworkPodCIDR              *string           `json:"podCidr,omitempty"`
+	LoadBalancerSKU             *string           `json:"loadBalancerSku,omitempty"`
+	VirtualNetwork              *string           `json:"virtualNetwork,omitempty"`
+	Subnet                      *string           `json:"subnet,omitempty"`
+	VirtualNetworkResourceGroup *string           `json:"virtualNetworkResourceGroup,omitempty"`
+	LinuxAdminUsername          *string           `json:"linuxAdminUsername,omitempty"`
+	LinuxSSHPublicKey           *string           `json:"sshPublicKey,omitempty"`
+	HTTPApplicationRouting      *bool             `json:"httpApplicationRouting,omitempty"`
+	Monitoring                  *bool             `json:"monitoring,omitempty"`
+	NodePools                   []AKSNodePool     `json:"nodePools,omitempty"`
+}
+
+// AKSNodePool is an agent pool of an AKS cluster.
+type AKSNodePool struct {
+	Name                string            `json:"name"`
+	Count               *int32            `json:"count,omitempty"`
+	MaxPods             *int32            `json:"maxPods,omitempty"`
+	VMSize              string            `json:"vmSize,omitempty"`
+	OsDiskSizeGB        *int32            `json:"osDiskSizeGB,omitempty"`
+	OsDiskType          string            `json:"osDiskType,omitempty"`
+	Mode                string            `json:"mode,omitempty"`
+	OsType              string            `json:"osType,omitempty"`
+	OrchestratorVersion *string           `json:"orchestratorVersion,omitempty"`
+	AvailabilityZones   []string          `json:"availabilityZones,omitempty"`
+	MaxSurge            string            `json:"maxSurge,omitempty"`
+	EnableAutoScaling   *bool             `json:"enableAutoScaling,omitempty"`
+	MinCount            *int32            `json:"minCount,omitempty"`
+	MaxCount            *int32            `json:"maxCount,omitempty"`
+	VnetSubnetID        *string           `json:"vnetSubnetID,omitempty"`
+	NodeLabels          map[string]string `json:"nodeLabels,omitempty"`
+	NodeTaints          []string          `json:"nodeTaints,omitempty"`
+}
+
+// AKSClusterParameters are the configurable fields of a AKSCluster.
+type AKSClusterParameters struct {
+	KubeconfigSecretNamespace string               `json:"kubeconfigSecretNamespace,omitempty"`
+	Description               string               `json:"description,omitempty"`
+	Labels                    map[string]string    `json:"labels,omitempty"`
+	AKSConfig                 AKSClusterConfigSpec `json:"aksConfig"`
+	// CloudCredentialRef is the name of the Rancher cloud credential used to
+	// provision the cluster. It is ignored when aksConfig.azureCredentialSecret
+	// is set.
+	// +optional
+	CloudCredentialRef string `json:"cloudCredentialRef,omitempty"`
+}
+
+// AKSClusterObservation are the observable fields of a AKSCluster.
+type AKSClusterObservation struct {
+	HostedClusterObservation `json:",inline"`
+}
+
+// A AKSClusterSpec defines the desired state of a AKSCluster.
+type AKSClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AKSClusterParameters `json:"forProvider"`
+}
+
+// A AKSClusterStatus represents the observed state of a AKSCluster.
+type AKSClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AKSClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A AKSCluster is an Azure AKS cluster provisioned through Rancher.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type AKSCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AKSClusterSpec   `json:"spec"`
+	Status AKSClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AKSClusterList contains a list of AKSCluster
+type AKSClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AKSCluster `json:"items"`
+}
+
+// AKSCluster type metadata.
+var (
+	AKSClusterKind             = reflect.TypeOf(AKSCluster{}).Name()
+	AKSClusterGroupKind        = schema.GroupKind{Group: Group, Kind: AKSClusterKind}.String()
+	AKSClusterKindAPIVersion   = AKSClusterKind + "." + SchemeGroupVersion.String()
+	AKSClusterGroupVersionKind = SchemeGroupVersion.WithKind(AKSClusterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AKSCluster{}, &AKSClusterList{})
+}
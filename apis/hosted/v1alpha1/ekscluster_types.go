@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EKSClusterConfigSpec is the eksConfig block of a Rancher cluster.
+type EKSClusterConfigSpec struct {
+	// AmazonCredentialSecret is the ID of the Rancher cloud credential used to
+	// provision the cluster, e.g. cattle-global-data:cc-xxxxx. It is resolved
+	// from cloudCredentialRef when not set.
+	// +optional
+	AmazonCredentialSecret string            `json:"amazonCredentialSecret,omitempty"`
+	DisplayName            string            `json:"displayName,omitempty"`
+	Region                 string            `json:"region"`
+	Imported               bool              `json:"imported,omitempty"`
+	KubernetesVersion      *string           `json:"kubernetesVersion,omitempty"`
+	Tags                   map[string]string `json:"tags,omitempty"`
+	SecretsEncryption      *bool             `json:"secretsEncryption,omitempty"`
+	KMSKey                 *string           `json:"kmsKey,omitempty"`
+	PublicAccess           *bool             `json:"publicAccess,omitempty"`
+	PrivateAccess          *bool             `json:"privateAccess,omitempty"`
+	EBSCSIDriver           *bool             `json:"ebsCSIDriver,omitempty"`
+	PublicAccessSources    []string          `json:"publicAccessSources,omitempty"`
+	LoggingTypes           []string          `json:"loggingTypes,omitempty"`
+	Subnets                []string          `json:"subnets,omitempty"`
+	SecurityGroups         []string          `json:"securityGroups,omitempty"`
+	ServiceRole            *string           `json:"serviceRole,omitempty"`
+	NodeGroups             []EKSNodeGroup    `json:"nodeGroups,omitempty"`
+}
+
+// EKSNodeGroup is a managed node group of an EKS cluster.
+type EKSNodeGroup struct {
+	NodegroupName        string                 `json:"nodegroupName"`
+	GPU                  *bool                  `json:"gpu,omitempty"`
+	ImageID              *string                `json:"imageId,omitempty"`
+	DiskSize             *int64                 `json:"diskSize,omitempty"`
+	InstanceType         *string                `json:"instanceType,omitempty"`
+	Labels               map[string]string      `json:"labels,omitempty"`
+	EC2SSHKey            *string                `json:"ec2SshKey,omitempty"`
+	DesiredSize          *int64                 `json:"desiredSize,omitempty"`
+	MaxSize              *int64                 `json:"maxSize,omitempty"`
+	MinSize              *int64                 `json:"minSize,omitempty"`
+	Subnets              []string               `json:"subnets,omitempty"`
+	Tags                 map[string]string      `json:"tags,omitempty"`
+	ResourceTags         map[string]string      `json:"resourceTags,omitempty"`
+	UserData             *string                `json:"userData,omitempty"`
+	Version              *string                `json:"version,omitempty"`
+	LaunchTemplate       *EKSNodeLaunchTemplate `json:"launchTemplate,omitempty"`
+	RequestSpotInstances *bool                  `json:"requestSpotInstances,omitempty"`
+	SpotInstanceTypes    []string               `json:"spotInstanceTypes,omitempty"`
+	NodeRole             *string                `json:"nodeRole,omitempty"`
+}
+
+// EKSNodeLaunchTemplate references an EC2 launch template for a node group.
+type EKSNodeLaunchTemplate struct {
+	ID      *string `json:"id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Version *int64  `json:"version,omitempty"`
+}
+
+// EKSClusterParameters are the configurable fields of a EKSCluster.
+type EKSClusterParameters struct {
+	KubeconfigSecretNamespace string               `json:"kubeconfigSecretNamespace,omitempty"`
+	Description               string               `json:"description,omitempty"`
+	Labels                    map[string]string    `json:"labels,omitempty"`
+	EKSConfig                 EKSClusterConfigSpec `json:"eksConfig"`
+	// CloudCredentialRef is the name of the Rancher cloud credential used to
+	// provision the cluster. It is ignored when eksConfig.amazonCredentialSecret
+	// is set.
+	// +optional
+	CloudCredentialRef string `json:"cloudCredentialRef,omitempty"`
+}
+
+// EKSClusterObservation are the observable fields of a EKSCluster.
+type EKSClusterObservation struct {
+	HostedClusterObservation `json:",inline"`
+}
+
+// A EKSClusterSpec defines the desired state of a EKSCluster.
+type EKSClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EKSClusterParameters `json:"forProvider"`
+}
+
+// A EKSClusterStatus represents the observed state of a EKSCluster.
+type EKSClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EKSClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A EKSCluster is an Amazon EKS cluster provisioned through Rancher.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type EKSCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EKSClusterSpec   `json:"spec"`
+	Status EKSClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EKSClusterList contains a list of EKSCluster
+type EKSClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EKSCluster `json:"items"`
+}
+
+// EKSCluster type metadata.
+var (
+	EKSClusterKind             = reflect.TypeOf(EKSCluster{}).Name()
+	EKSClusterGroupKind        = schema.GroupKind{Group: Group, Kind: EKSClusterKind}.String()
+	EKSClusterKindAPIVersion   = EKSClusterKind + "." + SchemeGroupVersion.String()
+	EKSClusterGroupVersionKind = SchemeGroupVersion.WithKind(EKSClusterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EKSCluster{}, &EKSClusterList{})
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GKEClusterConfigSpec is the gkeConfig block of a Rancher cluster.
+type GKEClusterConfigSpec struct {
+	// GoogleCredentialSecret is the ID of the Rancher cloud credential used to
+	// provision the cluster, e.g. cattle-global-data:cc-xxxxx. It is resolved
+	// from cloudCredentialRef when not set.
+	// +optional
+	GoogleCredentialSecret         string                             `json:"googleCredentialSecret,omitempty"`
+	ProjectID                      string                             `json:"projectID"`
+	ClusterName                    string                             `json:"clusterName,omitempty"`
+	Description                    string                             `json:"description,omitempty"`
+	Labels                         map[string]string                  `json:"labels,omitempty"`
+	Region                         string                             `json:"region,omitempty"`
+	Zone                           string                             `json:"zone,omitempty"`
+	Imported                       bool                               `json:"imported,omitempty"`
+	EnableKubernetesAlpha          *bool                              `json:"enableKubernetesAlpha,omitempty"`
+	ClusterAddons                  *GKEClusterAddons                  `json:"clusterAddons,omitempty"`
+	ClusterIpv4CidrBlock           *string                            `json:"clusterIpv4Cidr,omitempty"`
+	KubernetesVersion              *string                            `json:"kubernetesVersion,omitempty"`
+	LoggingService                 *string                            `json:"loggingService,omitempty"`
+	MonitoringService              *string                            `json:"monitoringService,omitempty"`
+	Network                        *string                            `json:"network,omitempty"`
+	Subnetwork                     *string                            `json:"subnetwork,omitempty"`
+	NetworkPolicyEnabled           *bool                              `json:"networkPolicyEnabled,omitempty"`
+	PrivateClusterConfig           *GKEPrivateClusterConfig           `json:"privateClusterConfig,omitempty"`
+	IPAllocationPolicy             *GKEIPAllocationPolicy             `json:"ipAllocationPolicy,omitempty"`
+	MasterAuthorizedNetworksConfig *GKEMasterAuthorizedNetworksConfig `json:"masterAuthorizedNetworks,omitempty"`
+	Locations                      []string                           `json:"locations,omitempty"`
+	MaintenanceWindow              *string                            `json:"maintenanceWindow,omitempty"`
+	NodePools                      []GKENodePoolConfig                `json:"nodePools,omitempty"`
+}
+
+// GKEClusterAddons toggles the GKE cluster add-ons.
+type GKEClusterAddons struct {
+	HTTPLoadBalancing        bool `json:"httpLoadBalancing,omitempty"`
+	HorizontalPodAutoscaling bool `json:"horizontalPodAutoscaling,omitempty"`
+	NetworkPolicyConfig      bool `json:"networkPolicyConfig,omitempty"`
+}
+
+// GKEPrivateClusterConfig configures a private GKE cluster.
+type GKEPrivateClusterConfig struct {
+	EnablePrivateEndpoint bool   `json:"enablePrivateEndpoint,omitempty"`
+	EnablePrivateNodes    bool   `json:"enablePrivateNodes,omitempty"`
+	MasterIpv4CidrBlock   string `json:"masterIpv4CidrBlock,omitempty"`
+}
+
+// GKEIPAllocationPolicy configures VPC-native networking of a GKE cluster.
+type GKEIPAllocationPolicy struct {
+	ClusterIpv4CidrBlock       string `json:"clusterIpv4CidrBlock,omitempty"`
+	ClusterSecondaryRangeName  string `json:"clusterSecondaryRangeName,omitempty"`
+	CreateSubnetwork           bool   `json:"createSubnetwork,omitempty"`
+	NodeIpv4CidrBlock          string `json:"nodeIpv4CidrBlock,omitempty"`
+	ServicesIpv4CidrBlock      string `json:"servicesIpv4CidrBlock,omitempty"`
+	ServicesSecondaryRangeName string `json:"servicesSecondaryRangeName,omitempty"`
+	SubnetworkName             string `json:"subnetworkName,omitempty"`
+	UseIPAliases               bool   `json:"useIpAliases,omitempty"`
+}
+
+// GKEMasterAuthorizedNetworksConfig restricts access to the GKE control plane.
+type GKEMasterAuthorizedNetworksConfig struct {
+	CidrBlocks []GKECidrBlock `json:"cidrBlocks,omitempty"`
+	Enabled    bool           `json:"enabled,omitempty"`
+}
+
+// GKECidrBlock is a CIDR allowed to reach the GKE control plane.
+type GKECidrBlock struct {
+	CidrBlock   string `json:"cidrBlock"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// GKENodePoolConfig is a node pool of a GKE cluster.
+type GKENodePoolConfig struct {
+	Name              string                  `json:"name"`
+	Autoscaling       *GKENodePoolAutoscaling `json:"autoscaling,omitempty"`
+	Config            *GKENodeConfig          `json:"config,omitempty"`
+	InitialNodeCount  *int64                  `json:"initialNodeCount,omitempty"`
+	MaxPodsConstraint *int64                  `json:"maxPodsConstraint,omitempty"`
+	Version           *string                 `json:"version,omitempty"`
+	Management        *GKENodePoolManagement  `json:"management,omitempty"`
+}
+
+// GKENodePoolAutoscaling configures the cluster autoscaler for a node pool.
+type GKENodePoolAutoscaling struct {
+	Enabled      bool  `json:"enabled,omitempty"`
+	MaxNodeCount int64 `json:"maxNodeCount,omitempty"`
+	MinNodeCount int64 `json:"minNodeCount,omitempty"`
+}
+
+// GKENodeConfig describes the machines of a node pool.
+type GKENodeConfig struct {
+	DiskSizeGb    int64                `json:"diskSizeGb,omitempty"`
+	DiskType      string               `json:"diskType,omitempty"`
+	ImageType     string               `json:"imageType,omitempty"`
+	Labels        map[string]string    `json:"labels,omitempty"`
+	LocalSsdCount int64                `json:"localSsdCount,omitempty"`
+	MachineType   string               `json:"machineType,omitempty"`
+	OauthScopes   []string             `json:"oauthScopes,omitempty"`
+	Preemptible   bool                 `json:"preemptible,omitempty"`
+	Tags          []string             `json:"tags,omitempty"`
+	Taints        []GKENodeTaintConfig `json:"taints,omitempty"`
+}
+
+// GKENodeTaintConfig is a taint applied to the nodes of a node pool.
+type GKENodeTaintConfig struct {
+	Effect string `json:"effect"`
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+}
+
+// GKENodePoolManagement configures auto repair and upgrade of a node pool.
+type GKENodePoolManagement struct {
+	AutoRepair  bool `json:"autoRepair,omitempty"`
+	AutoUpgrade bool `json:"autoUpgrade,omitempty"`
+}
+
+// GKEClusterParameters are the configurable fields of a GKECluster.
+type GKEClusterParameters struct {
+	KubeconfigSecretNamespace string               `json:"kubeconfigSecretNamespace,omitempty"`
+	Description               string               `json:"description,omitempty"`
+	Labels                    map[string]string    `json:"labels,omitempty"`
+	GKEConfig                 GKEClusterConfigSpec `json:"gkeConfig"`
+	// CloudCredentialRef is the name of the Rancher cloud credential used to
+	// provision the cluster. It is ignored when gkeConfig.googleCredentialSecret
+	// is set.
+	// +optional
+	CloudCredentialRef string `json:"cloudCredentialRef,omitempty"`
+}
+
+// GKEClusterObservation are the observable fields of a GKECluster.
+type GKEClusterObservation struct {
+	HostedClusterObservation `json:",inline"`
+}
+
+// A GKEClusterSpec defines the desired state of a GKECluster.
+type GKEClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GKEClusterParameters `json:"forProvider"`
+}
+
+// A GKEClusterStatus represents the observed state of a GKECluster.
+type GKEClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GKEClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GKECluster is a Google GKE cluster provisioned through Rancher.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type GKECluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GKEClusterSpec   `json:"spec"`
+	Status GKEClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GKEClusterList contains a list of GKECluster
+type GKEClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GKECluster `json:"items"`
+}
+
+// GKECluster type metadata.
+var (
+	GKEClusterKind             = reflect.TypeOf(GKECluster{}).Name()
+	GKEClusterGroupKind        = schema.GroupKind{Group: Group, Kind: GKEClusterKind}.String()
+	GKEClusterKindAPIVersion   = GKEClusterKind + "." + SchemeGroupVersion.String()
+	GKEClusterGroupVersionKind = SchemeGroupVersion.WithKind(GKEClusterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GKECluster{}, &GKEClusterList{})
+}
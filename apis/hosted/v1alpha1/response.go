@@ -0,0 +1,55 @@
+package v1alpha1
+
+type HostedClusterResponse struct {
+	Data []HostedClusterData `json:"data"`
+}
+
+type HostedClusterData struct {
+	ID                   string                `json:"id"`
+	Name                 string                `json:"name"`
+	State                string                `json:"state"`
+	TransitioningMessage string                `json:"transitioningMessage"`
+	Version              *HostedClusterVersion `json:"version,omitempty"`
+	EKSStatus            *EKSStatus            `json:"eksStatus,omitempty"`
+	AKSStatus            *AKSStatus            `json:"aksStatus,omitempty"`
+	GKEStatus            *GKEStatus            `json:"gkeStatus,omitempty"`
+	EKSConfig            *EKSClusterConfigSpec `json:"eksConfig,omitempty"`
+	AKSConfig            *AKSClusterConfigSpec `json:"aksConfig,omitempty"`
+	GKEConfig            *GKEClusterConfigSpec `json:"gkeConfig,omitempty"`
+}
+
+type HostedClusterVersion struct {
+	GitVersion string `json:"gitVersion"`
+}
+
+type EKSStatus struct {
+	UpstreamSpec *EKSClusterConfigSpec `json:"upstreamSpec,omitempty"`
+}
+
+type AKSStatus struct {
+	UpstreamSpec *AKSClusterConfigSpec `json:"upstreamSpec,omitempty"`
+}
+
+type GKEStatus struct {
+	UpstreamSpec *GKEClusterConfigSpec `json:"upstreamSpec,omitempty"`
+}
+
+// HostedClusterRequest is the body sent to /v3/clusters for hosted clusters.
+type HostedClusterRequest struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	Labels      map[string]string     `json:"labels,omitempty"`
+	EKSConfig   *EKSClusterConfigSpec `json:"eksConfig,omitempty"`
+	AKSConfig   *AKSClusterConfigSpec `json:"aksConfig,omitempty"`
+	GKEConfig   *GKEClusterConfigSpec `json:"gkeConfig,omitempty"`
+}
+
+type CloudCredentialResponse struct {
+	Data []CloudCredentialData `json:"data"`
+}
+
+// CloudCredentialData is a Rancher cloud credential.
+type CloudCredentialData struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
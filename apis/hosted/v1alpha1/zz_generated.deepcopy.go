@@ -0,0 +1,1383 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSCluster) DeepCopyInto(out *AKSCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSCluster.
+func (in *AKSCluster) DeepCopy() *AKSCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AKSCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterConfigSpec) DeepCopyInto(out *AKSClusterConfigSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSPrefix != nil {
+		in, out := &in.DNSPrefix, &out.DNSPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeResourceGroup != nil {
+		in, out := &in.NodeResourceGroup, &out.NodeResourceGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateCluster != nil {
+		in, out := &in.PrivateCluster, &out.PrivateCluster
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuthorizedIPRanges != nil {
+		in, out := &in.AuthorizedIPRanges, &out.AuthorizedIPRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkPlugin != nil {
+		in, out := &in.NetworkPlugin, &out.NetworkPlugin
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkDNSServiceIP != nil {
+		in, out := &in.NetworkDNSServiceIP, &out.NetworkDNSServiceIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkDockerBridgeCIDR != nil {
+		in, out := &in.NetworkDockerBridgeCIDR, &out.NetworkDockerBridgeCIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkServiceCIDR != nil {
+		in, out := &in.NetworkServiceCIDR, &out.NetworkServiceCIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkPodCIDR != nil {
+		in, out := &in.NetworkPodCIDR, &out.NetworkPodCIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoadBalancerSKU != nil {
+		in, out := &in.LoadBalancerSKU, &out.LoadBalancerSKU
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualNetwork != nil {
+		in, out := &in.VirtualNetwork, &out.VirtualNetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualNetworkResourceGroup != nil {
+		in, out := &in.VirtualNetworkResourceGroup, &out.VirtualNetworkResourceGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.LinuxAdminUsername != nil {
+		in, out := &in.LinuxAdminUsername, &out.LinuxAdminUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.LinuxSSHPublicKey != nil {
+		in, out := &in.LinuxSSHPublicKey, &out.LinuxSSHPublicKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPApplicationRouting != nil {
+		in, out := &in.HTTPApplicationRouting, &out.HTTPApplicationRouting
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]AKSNodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterConfigSpec.
+func (in *AKSClusterConfigSpec) DeepCopy() *AKSClusterConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterList) DeepCopyInto(out *AKSClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AKSCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterList.
+func (in *AKSClusterList) DeepCopy() *AKSClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AKSClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterObservation) DeepCopyInto(out *AKSClusterObservation) {
+	*out = *in
+	out.HostedClusterObservation = in.HostedClusterObservation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterObservation.
+func (in *AKSClusterObservation) DeepCopy() *AKSClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterParameters) DeepCopyInto(out *AKSClusterParameters) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.AKSConfig.DeepCopyInto(&out.AKSConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterParameters.
+func (in *AKSClusterParameters) DeepCopy() *AKSClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterSpec) DeepCopyInto(out *AKSClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterSpec.
+func (in *AKSClusterSpec) DeepCopy() *AKSClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSClusterStatus) DeepCopyInto(out *AKSClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSClusterStatus.
+func (in *AKSClusterStatus) DeepCopy() *AKSClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSNodePool) DeepCopyInto(out *AKSNodePool) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OsDiskSizeGB != nil {
+		in, out := &in.OsDiskSizeGB, &out.OsDiskSizeGB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OrchestratorVersion != nil {
+		in, out := &in.OrchestratorVersion, &out.OrchestratorVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnableAutoScaling != nil {
+		in, out := &in.EnableAutoScaling, &out.EnableAutoScaling
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinCount != nil {
+		in, out := &in.MinCount, &out.MinCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.VnetSubnetID != nil {
+		in, out := &in.VnetSubnetID, &out.VnetSubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSNodePool.
+func (in *AKSNodePool) DeepCopy() *AKSNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSStatus) DeepCopyInto(out *AKSStatus) {
+	*out = *in
+	if in.UpstreamSpec != nil {
+		in, out := &in.UpstreamSpec, &out.UpstreamSpec
+		*out = new(AKSClusterConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSStatus.
+func (in *AKSStatus) DeepCopy() *AKSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudCredentialData) DeepCopyInto(out *CloudCredentialData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudCredentialData.
+func (in *CloudCredentialData) DeepCopy() *CloudCredentialData {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudCredentialData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudCredentialResponse) DeepCopyInto(out *CloudCredentialResponse) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]CloudCredentialData, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudCredentialResponse.
+func (in *CloudCredentialResponse) DeepCopy() *CloudCredentialResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudCredentialResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSCluster) DeepCopyInto(out *EKSCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSCluster.
+func (in *EKSCluster) DeepCopy() *EKSCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EKSCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSClusterConfigSpec) DeepCopyInto(out *EKSClusterConfigSpec) {
+	*out = *in
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretsEncryption != nil {
+		in, out := &in.SecretsEncryption, &out.SecretsEncryption
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSKey != nil {
+		in, out := &in.KMSKey, &out.KMSKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicAccess != nil {
+		in, out := &in.PublicAccess, &out.PublicAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateAccess != nil {
+		in, out := &in.PrivateAccess, &out.PrivateAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EBSCSIDriver != nil {
+		in, out := &in.EBSCSIDriver, &out.EBSCSIDriver
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicAccessSources != nil {
+		in, out := &in.PublicAccessSources, &out.PublicAccessSources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoggingTypes != nil {
+		in, out := &in.LoggingTypes, &out.LoggingTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceRole != nil {
+		in, out := &in.ServiceRole, &out.ServiceRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeGroups != nil {
+		in, out := &in.NodeGroups, &out.NodeGroups
+		*out = make([]EKSNodeGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterConfigSpec.
+func (in *EKSClusterConfigSpec) DeepCopy() *EKSClusterConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSClusterConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSClusterList) DeepCopyInto(out *EKSClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EKSCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterList.
+func (in *EKSClusterList) DeepCopy() *EKSClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EKSClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSClusterObservation) DeepCopyInto(out *EKSClusterObservation) {
+	*out = *in
+	out.HostedClusterObservation = in.HostedClusterObservation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterObservation.
+func (in *EKSClusterObservation) DeepCopy() *EKSClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSClusterParameters) DeepCopyInto(out *EKSClusterParameters) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.EKSConfig.DeepCopyInto(&out.EKSConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterParameters.
+func (in *EKSClusterParameters) DeepCopy() *EKSClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSClusterSpec) DeepCopyInto(out *EKSClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterSpec.
+func (in *EKSClusterSpec) DeepCopy() *EKSClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSClusterStatus) DeepCopyInto(out *EKSClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterStatus.
+func (in *EKSClusterStatus) DeepCopy() *EKSClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSNodeGroup) DeepCopyInto(out *EKSNodeGroup) {
+	*out = *in
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ImageID != nil {
+		in, out := &in.ImageID, &out.ImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiskSize != nil {
+		in, out := &in.DiskSize, &out.DiskSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InstanceType != nil {
+		in, out := &in.InstanceType, &out.InstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EC2SSHKey != nil {
+		in, out := &in.EC2SSHKey, &out.EC2SSHKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.DesiredSize != nil {
+		in, out := &in.DesiredSize, &out.DesiredSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourceTags != nil {
+		in, out := &in.ResourceTags, &out.ResourceTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTemplate != nil {
+		in, out := &in.LaunchTemplate, &out.LaunchTemplate
+		*out = new(EKSNodeLaunchTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestSpotInstances != nil {
+		in, out := &in.RequestSpotInstances, &out.RequestSpotInstances
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SpotInstanceTypes != nil {
+		in, out := &in.SpotInstanceTypes, &out.SpotInstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeRole != nil {
+		in, out := &in.NodeRole, &out.NodeRole
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSNodeGroup.
+func (in *EKSNodeGroup) DeepCopy() *EKSNodeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSNodeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSNodeLaunchTemplate) DeepCopyInto(out *EKSNodeLaunchTemplate) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSNodeLaunchTemplate.
+func (in *EKSNodeLaunchTemplate) DeepCopy() *EKSNodeLaunchTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSNodeLaunchTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSStatus) DeepCopyInto(out *EKSStatus) {
+	*out = *in
+	if in.UpstreamSpec != nil {
+		in, out := &in.UpstreamSpec, &out.UpstreamSpec
+		*out = new(EKSClusterConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSStatus.
+func (in *EKSStatus) DeepCopy() *EKSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKECidrBlock) DeepCopyInto(out *GKECidrBlock) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKECidrBlock.
+func (in *GKECidrBlock) DeepCopy() *GKECidrBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(GKECidrBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKECluster) DeepCopyInto(out *GKECluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKECluster.
+func (in *GKECluster) DeepCopy() *GKECluster {
+	if in == nil {
+		return nil
+	}
+	out := new(GKECluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GKECluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEClusterAddons) DeepCopyInto(out *GKEClusterAddons) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEClusterAddons.
+func (in *GKEClusterAddons) DeepCopy() *GKEClusterAddons {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEClusterAddons)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEClusterConfigSpec) DeepCopyInto(out *GKEClusterConfigSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EnableKubernetesAlpha != nil {
+		in, out := &in.EnableKubernetesAlpha, &out.EnableKubernetesAlpha
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClusterAddons != nil {
+		in, out := &in.ClusterAddons, &out.ClusterAddons
+		*out = new(GKEClusterAddons)
+		**out = **in
+	}
+	if in.ClusterIpv4CidrBlock != nil {
+		in, out := &in.ClusterIpv4CidrBlock, &out.ClusterIpv4CidrBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoggingService != nil {
+		in, out := &in.LoggingService, &out.LoggingService
+		*out = new(string)
+		**out = **in
+	}
+	if in.MonitoringService != nil {
+		in, out := &in.MonitoringService, &out.MonitoringService
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnetwork != nil {
+		in, out := &in.Subnetwork, &out.Subnetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkPolicyEnabled != nil {
+		in, out := &in.NetworkPolicyEnabled, &out.NetworkPolicyEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateClusterConfig != nil {
+		in, out := &in.PrivateClusterConfig, &out.PrivateClusterConfig
+		*out = new(GKEPrivateClusterConfig)
+		**out = **in
+	}
+	if in.IPAllocationPolicy != nil {
+		in, out := &in.IPAllocationPolicy, &out.IPAllocationPolicy
+		*out = new(GKEIPAllocationPolicy)
+		**out = **in
+	}
+	if in.MasterAuthorizedNetworksConfig != nil {
+		in, out := &in.MasterAuthorizedNetworksConfig, &out.MasterAuthorizedNetworksConfig
+		*out = new(GKEMasterAuthorizedNetworksConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]GKENodePoolConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEClusterConfigSpec.
+func (in *GKEClusterConfigSpec) DeepCopy() *GKEClusterConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEClusterConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEClusterList) DeepCopyInto(out *GKEClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GKECluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEClusterList.
+func (in *GKEClusterList) DeepCopy() *GKEClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GKEClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEClusterObservation) DeepCopyInto(out *GKEClusterObservation) {
+	*out = *in
+	out.HostedClusterObservation = in.HostedClusterObservation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEClusterObservation.
+func (in *GKEClusterObservation) DeepCopy() *GKEClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEClusterParameters) DeepCopyInto(out *GKEClusterParameters) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.GKEConfig.DeepCopyInto(&out.GKEConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEClusterParameters.
+func (in *GKEClusterParameters) DeepCopy() *GKEClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEClusterSpec) DeepCopyInto(out *GKEClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEClusterSpec.
+func (in *GKEClusterSpec) DeepCopy() *GKEClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEClusterStatus) DeepCopyInto(out *GKEClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEClusterStatus.
+func (in *GKEClusterStatus) DeepCopy() *GKEClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEIPAllocationPolicy) DeepCopyInto(out *GKEIPAllocationPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEIPAllocationPolicy.
+func (in *GKEIPAllocationPolicy) DeepCopy() *GKEIPAllocationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEIPAllocationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEMasterAuthorizedNetworksConfig) DeepCopyInto(out *GKEMasterAuthorizedNetworksConfig) {
+	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]GKECidrBlock, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEMasterAuthorizedNetworksConfig.
+func (in *GKEMasterAuthorizedNetworksConfig) DeepCopy() *GKEMasterAuthorizedNetworksConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEMasterAuthorizedNetworksConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKENodeConfig) DeepCopyInto(out *GKENodeConfig) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.OauthScopes != nil {
+		in, out := &in.OauthScopes, &out.OauthScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]GKENodeTaintConfig, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKENodeConfig.
+func (in *GKENodeConfig) DeepCopy() *GKENodeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GKENodeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKENodePoolAutoscaling) DeepCopyInto(out *GKENodePoolAutoscaling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKENodePoolAutoscaling.
+func (in *GKENodePoolAutoscaling) DeepCopy() *GKENodePoolAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(GKENodePoolAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKENodePoolConfig) DeepCopyInto(out *GKENodePoolConfig) {
+	*out = *in
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(GKENodePoolAutoscaling)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(GKENodeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InitialNodeCount != nil {
+		in, out := &in.InitialNodeCount, &out.InitialNodeCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxPodsConstraint != nil {
+		in, out := &in.MaxPodsConstraint, &out.MaxPodsConstraint
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Management != nil {
+		in, out := &in.Management, &out.Management
+		*out = new(GKENodePoolManagement)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKENodePoolConfig.
+func (in *GKENodePoolConfig) DeepCopy() *GKENodePoolConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GKENodePoolConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKENodePoolManagement) DeepCopyInto(out *GKENodePoolManagement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKENodePoolManagement.
+func (in *GKENodePoolManagement) DeepCopy() *GKENodePoolManagement {
+	if in == nil {
+		return nil
+	}
+	out := new(GKENodePoolManagement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKENodeTaintConfig) DeepCopyInto(out *GKENodeTaintConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKENodeTaintConfig.
+func (in *GKENodeTaintConfig) DeepCopy() *GKENodeTaintConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GKENodeTaintConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEPrivateClusterConfig) DeepCopyInto(out *GKEPrivateClusterConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEPrivateClusterConfig.
+func (in *GKEPrivateClusterConfig) DeepCopy() *GKEPrivateClusterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEPrivateClusterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEStatus) DeepCopyInto(out *GKEStatus) {
+	*out = *in
+	if in.UpstreamSpec != nil {
+		in, out := &in.UpstreamSpec, &out.UpstreamSpec
+		*out = new(GKEClusterConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEStatus.
+func (in *GKEStatus) DeepCopy() *GKEStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostedClusterData) DeepCopyInto(out *HostedClusterData) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(HostedClusterVersion)
+		**out = **in
+	}
+	if in.EKSStatus != nil {
+		in, out := &in.EKSStatus, &out.EKSStatus
+		*out = new(EKSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AKSStatus != nil {
+		in, out := &in.AKSStatus, &out.AKSStatus
+		*out = new(AKSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GKEStatus != nil {
+		in, out := &in.GKEStatus, &out.GKEStatus
+		*out = new(GKEStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EKSConfig != nil {
+		in, out := &in.EKSConfig, &out.EKSConfig
+		*out = new(EKSClusterConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AKSConfig != nil {
+		in, out := &in.AKSConfig, &out.AKSConfig
+		*out = new(AKSClusterConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GKEConfig != nil {
+		in, out := &in.GKEConfig, &out.GKEConfig
+		*out = new(GKEClusterConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedClusterData.
+func (in *HostedClusterData) DeepCopy() *HostedClusterData {
+	if in == nil {
+		return nil
+	}
+	out := new(HostedClusterData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostedClusterObservation) DeepCopyInto(out *HostedClusterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedClusterObservation.
+func (in *HostedClusterObservation) DeepCopy() *HostedClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HostedClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostedClusterRequest) DeepCopyInto(out *HostedClusterRequest) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EKSConfig != nil {
+		in, out := &in.EKSConfig, &out.EKSConfig
+		*out = new(EKSClusterConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AKSConfig != nil {
+		in, out := &in.AKSConfig, &out.AKSConfig
+		*out = new(AKSClusterConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GKEConfig != nil {
+		in, out := &in.GKEConfig, &out.GKEConfig
+		*out = new(GKEClusterConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedClusterRequest.
+func (in *HostedClusterRequest) DeepCopy() *HostedClusterRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HostedClusterRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostedClusterResponse) DeepCopyInto(out *HostedClusterResponse) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]HostedClusterData, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedClusterResponse.
+func (in *HostedClusterResponse) DeepCopy() *HostedClusterResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(HostedClusterResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostedClusterVersion) DeepCopyInto(out *HostedClusterVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedClusterVersion.
+func (in *HostedClusterVersion) DeepCopy() *HostedClusterVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(HostedClusterVersion)
+	in.DeepCopyInto(out)
+	return out
+}
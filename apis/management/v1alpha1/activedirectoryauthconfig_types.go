@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ActiveDirectoryConfig are the settings of the Active Directory provider.
+type ActiveDirectoryConfig struct {
+	LDAPConfig `json:",inline"`
+	// DefaultLoginDomain is prepended to usernames given without a domain.
+	DefaultLoginDomain     string `json:"defaultLoginDomain,omitempty"`
+	ServiceAccountUsername string `json:"serviceAccountUsername"`
+	UserEnabledAttribute   string `json:"userEnabledAttribute,omitempty"`
+	UserDisabledBitMask    *int64 `json:"userDisabledBitMask,omitempty"`
+}
+
+// ActiveDirectoryAuthConfigParameters are the configurable fields of a ActiveDirectoryAuthConfig.
+type ActiveDirectoryAuthConfigParameters struct {
+	ActiveDirectoryConfig `json:",inline"`
+	AuthConfigAccess      `json:",inline"`
+	LDAPTestCredentials   `json:",inline"`
+	// ServiceAccountPasswordSecretRef selects the Secret key holding the
+	// password of the service account.
+	ServiceAccountPasswordSecretRef xpv1.SecretKeySelector `json:"serviceAccountPasswordSecretRef"`
+}
+
+// ActiveDirectoryAuthConfigObservation are the observable fields of a ActiveDirectoryAuthConfig.
+type ActiveDirectoryAuthConfigObservation struct {
+	// Enabled reports whether the provider is enabled in Rancher.
+	Enabled bool `json:"enabled,omitempty"`
+	// SecretVersion is the resource version of the referenced Secrets the
+	// provider was last configured from.
+	SecretVersion string `json:"secretVersion,omitempty"`
+}
+
+// A ActiveDirectoryAuthConfigSpec defines the desired state of a ActiveDirectoryAuthConfig.
+type ActiveDirectoryAuthConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ActiveDirectoryAuthConfigParameters `json:"forProvider"`
+}
+
+// A ActiveDirectoryAuthConfigStatus represents the observed state of a ActiveDirectoryAuthConfig.
+type ActiveDirectoryAuthConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ActiveDirectoryAuthConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ActiveDirectoryAuthConfig configures and enables the Active Directory
+// authentication provider of Rancher.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type ActiveDirectoryAuthConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ActiveDirectoryAuthConfigSpec   `json:"spec"`
+	Status ActiveDirectoryAuthConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ActiveDirectoryAuthConfigList contains a list of ActiveDirectoryAuthConfig
+type ActiveDirectoryAuthConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ActiveDirectoryAuthConfig `json:"items"`
+}
+
+// ActiveDirectoryAuthConfig type metadata.
+var (
+	ActiveDirectoryAuthConfigKind             = reflect.TypeOf(ActiveDirectoryAuthConfig{}).Name()
+	ActiveDirectoryAuthConfigGroupKind        = schema.GroupKind{Group: Group, Kind: ActiveDirectoryAuthConfigKind}.String()
+	ActiveDirectoryAuthConfigKindAPIVersion   = ActiveDirectoryAuthConfigKind + "." + SchemeGroupVersion.String()
+	ActiveDirectoryAuthConfigGroupVersionKind = SchemeGroupVersion.WithKind(ActiveDirectoryAuthConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ActiveDirectoryAuthConfig{}, &ActiveDirectoryAuthConfigList{})
+}
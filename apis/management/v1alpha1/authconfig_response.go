@@ -0,0 +1,61 @@
+package v1alpha1
+
+// GitHubConfigData is the GitHub provider configuration of Rancher.
+type GitHubConfigData struct {
+	Enabled          bool `json:"enabled"`
+	GitHubConfig     `json:",inline"`
+	AuthConfigAccess `json:",inline"`
+	ClientSecret     string `json:"clientSecret,omitempty"`
+}
+
+// ActiveDirectoryConfigData is the Active Directory provider configuration of
+// Rancher.
+type ActiveDirectoryConfigData struct {
+	Enabled                bool `json:"enabled"`
+	ActiveDirectoryConfig  `json:",inline"`
+	AuthConfigAccess       `json:",inline"`
+	ServiceAccountPassword string `json:"serviceAccountPassword,omitempty"`
+}
+
+// ActiveDirectoryTestAndApplyInput is the input of the testAndApply action of
+// the Active Directory provider.
+type ActiveDirectoryTestAndApplyInput struct {
+	ActiveDirectoryConfig ActiveDirectoryConfigData `json:"activeDirectoryConfig"`
+	Username              string                    `json:"username"`
+	Password              string                    `json:"password"`
+	Enabled               bool                      `json:"enabled"`
+}
+
+// OpenLDAPConfigData is the OpenLDAP provider configuration of Rancher.
+type OpenLDAPConfigData struct {
+	Enabled                bool `json:"enabled"`
+	OpenLDAPConfig         `json:",inline"`
+	AuthConfigAccess       `json:",inline"`
+	ServiceAccountPassword string `json:"serviceAccountPassword,omitempty"`
+}
+
+// OpenLDAPTestAndApplyInput is the input of the testAndApply action of the
+// OpenLDAP provider.
+type OpenLDAPTestAndApplyInput struct {
+	LDAPConfig OpenLDAPConfigData `json:"ldapConfig"`
+	Username   string             `json:"username"`
+	Password   string             `json:"password"`
+}
+
+// KeyCloakOIDCConfigData is the Keycloak OIDC provider configuration of
+// Rancher.
+type KeyCloakOIDCConfigData struct {
+	Enabled            bool `json:"enabled"`
+	KeyCloakOIDCConfig `json:",inline"`
+	AuthConfigAccess   `json:",inline"`
+	ClientSecret       string `json:"clientSecret,omitempty"`
+	PrivateKey         string `json:"privateKey,omitempty"`
+}
+
+// SAMLConfigData is the configuration of a SAML provider of Rancher.
+type SAMLConfigData struct {
+	Enabled          bool `json:"enabled"`
+	SAMLConfig       `json:",inline"`
+	AuthConfigAccess `json:",inline"`
+	SPKey            string `json:"spKey,omitempty"`
+}
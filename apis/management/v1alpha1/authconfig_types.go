@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AuthConfigAccess controls which principals may log in through an
+// authentication provider.
+type AuthConfigAccess struct {
+	// AccessMode is unrestricted to allow every user of the provider,
+	// restricted to allow allowed principals and members of clusters and
+	// projects, or required to allow allowed principals only.
+	// +kubebuilder:validation:Enum=unrestricted;restricted;required
+	// +kubebuilder:default=unrestricted
+	AccessMode string `json:"accessMode,omitempty"`
+	// AllowedPrincipalIDs are the principals allowed to log in when the
+	// access mode is restricted or required, e.g. github_user://12345.
+	AllowedPrincipalIDs []string `json:"allowedPrincipalIds,omitempty"`
+}
+
+// LDAPConfig holds the connection and schema settings shared by the Active
+// Directory and OpenLDAP providers.
+type LDAPConfig struct {
+	Servers []string `json:"servers"`
+	Port    *int64   `json:"port,omitempty"`
+	TLS     *bool    `json:"tls,omitempty"`
+	// StartTLS upgrades a plain connection to TLS.
+	StartTLS *bool `json:"starttls,omitempty"`
+	// Certificate is the PEM encoded CA certificate of the servers.
+	Certificate string `json:"certificate,omitempty"`
+	// ConnectionTimeout in milliseconds.
+	ConnectionTimeout *int64 `json:"connectionTimeout,omitempty"`
+
+	UserSearchBase      string `json:"userSearchBase"`
+	UserSearchAttribute string `json:"userSearchAttribute,omitempty"`
+	UserSearchFilter    string `json:"userSearchFilter,omitempty"`
+	UserLoginAttribute  string `json:"userLoginAttribute,omitempty"`
+	UserObjectClass     string `json:"userObjectClass,omitempty"`
+	UserNameAttribute   string `json:"userNameAttribute,omitempty"`
+
+	GroupSearchBase             string `json:"groupSearchBase,omitempty"`
+	GroupSearchAttribute        string `json:"groupSearchAttribute,omitempty"`
+	GroupSearchFilter           string `json:"groupSearchFilter,omitempty"`
+	GroupObjectClass            string `json:"groupObjectClass,omitempty"`
+	GroupNameAttribute          string `json:"groupNameAttribute,omitempty"`
+	GroupDNAttribute            string `json:"groupDNAttribute,omitempty"`
+	GroupMemberUserAttribute    string `json:"groupMemberUserAttribute,omitempty"`
+	GroupMemberMappingAttribute string `json:"groupMemberMappingAttribute,omitempty"`
+	// NestedGroupMembershipEnabled resolves groups of groups.
+	NestedGroupMembershipEnabled *bool `json:"nestedGroupMembershipEnabled,omitempty"`
+}
+
+// LDAPTestCredentials are the credentials of a directory user used to test
+// an LDAP provider before it is enabled.
+type LDAPTestCredentials struct {
+	TestUsername          string                 `json:"testUsername"`
+	TestPasswordSecretRef xpv1.SecretKeySelector `json:"testPasswordSecretRef"`
+}
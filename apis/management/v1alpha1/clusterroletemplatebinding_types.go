@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ClusterRoleTemplateBindingParameters are the configurable fields of a ClusterRoleTemplateBinding.
+type ClusterRoleTemplateBindingParameters struct {
+	// ClusterID is the Rancher ID of the cluster the role is granted on.
+	ClusterID string `json:"clusterId,omitempty"`
+	// ClusterIDRef is the name of the cluster, e.g. the name of a
+	// RKE1Cluster. It is resolved to ClusterID.
+	ClusterIDRef string `json:"clusterIdRef,omitempty"`
+
+	// RoleTemplateID is the ID of the cluster role template to grant, e.g.
+	// cluster-owner or cluster-member.
+	RoleTemplateID string `json:"roleTemplateId,omitempty"`
+	// RoleTemplateIDRef is the name of a RoleTemplate managed resource. It
+	// is resolved to RoleTemplateID once the RoleTemplate has been created.
+	RoleTemplateIDRef string `json:"roleTemplateIdRef,omitempty"`
+
+	Subject `json:",inline"`
+}
+
+// ClusterRoleTemplateBindingObservation are the observable fields of a ClusterRoleTemplateBinding.
+type ClusterRoleTemplateBindingObservation struct {
+	ID               string `json:"id,omitempty"`
+	ClusterID        string `json:"clusterId,omitempty"`
+	UserID           string `json:"userId,omitempty"`
+	GroupPrincipalID string `json:"groupPrincipalId,omitempty"`
+}
+
+// A ClusterRoleTemplateBindingSpec defines the desired state of a ClusterRoleTemplateBinding.
+type ClusterRoleTemplateBindingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterRoleTemplateBindingParameters `json:"forProvider"`
+}
+
+// A ClusterRoleTemplateBindingStatus represents the observed state of a ClusterRoleTemplateBinding.
+type ClusterRoleTemplateBindingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterRoleTemplateBindingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterRoleTemplateBinding grants a role template to a user or group on a
+// cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type ClusterRoleTemplateBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterRoleTemplateBindingSpec   `json:"spec"`
+	Status ClusterRoleTemplateBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterRoleTemplateBindingList contains a list of ClusterRoleTemplateBinding
+type ClusterRoleTemplateBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterRoleTemplateBinding `json:"items"`
+}
+
+// ClusterRoleTemplateBinding type metadata.
+var (
+	ClusterRoleTemplateBindingKind             = reflect.TypeOf(ClusterRoleTemplateBinding{}).Name()
+	ClusterRoleTemplateBindingGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterRoleTemplateBindingKind}.String()
+	ClusterRoleTemplateBindingKindAPIVersion   = ClusterRoleTemplateBindingKind + "." + SchemeGroupVersion.String()
+	ClusterRoleTemplateBindingGroupVersionKind = SchemeGroupVersion.WithKind(ClusterRoleTemplateBindingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ClusterRoleTemplateBinding{}, &ClusterRoleTemplateBindingList{})
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FeatureParameters are the configurable fields of a Feature.
+type FeatureParameters struct {
+	// Name of the feature flag. Defaults to the name of the managed resource.
+	Name string `json:"name,omitempty"`
+	// Value enables or disables the feature.
+	Value bool `json:"value"`
+}
+
+// FeatureObservation are the observable fields of a Feature.
+type FeatureObservation struct {
+	// Value is the effective value of the feature flag.
+	Value   bool `json:"value,omitempty"`
+	Default bool `json:"default,omitempty"`
+	// Customized reports whether the effective value differs from the
+	// default.
+	Customized bool `json:"customized,omitempty"`
+	// Dynamic reports whether a change takes effect without restarting
+	// Rancher.
+	Dynamic bool `json:"dynamic,omitempty"`
+	// LockedValue is set when the flag is locked to a value.
+	LockedValue *bool `json:"lockedValue,omitempty"`
+}
+
+// A FeatureSpec defines the desired state of a Feature.
+type FeatureSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FeatureParameters `json:"forProvider"`
+}
+
+// A FeatureStatus represents the observed state of a Feature.
+type FeatureStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FeatureObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Feature is a Rancher feature flag. Deleting the Feature resets the flag
+// to its default, unless the deletion policy is Orphan.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VALUE",type="boolean",JSONPath=".status.atProvider.value"
+// +kubebuilder:printcolumn:name="CUSTOMIZED",type="boolean",JSONPath=".status.atProvider.customized"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type Feature struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FeatureSpec   `json:"spec"`
+	Status FeatureStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FeatureList contains a list of Feature
+type FeatureList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Feature `json:"items"`
+}
+
+// Feature type metadata.
+var (
+	FeatureKind             = reflect.TypeOf(Feature{}).Name()
+	FeatureGroupKind        = schema.GroupKind{Group: Group, Kind: FeatureKind}.String()
+	FeatureKindAPIVersion   = FeatureKind + "." + SchemeGroupVersion.String()
+	FeatureGroupVersionKind = SchemeGroupVersion.WithKind(FeatureKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Feature{}, &FeatureList{})
+}
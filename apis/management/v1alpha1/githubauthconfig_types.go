@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GitHubConfig are the settings of the GitHub provider.
+type GitHubConfig struct {
+	// Hostname of the GitHub server. Defaults to github.com.
+	Hostname string `json:"hostname,omitempty"`
+	TLS      *bool  `json:"tls,omitempty"`
+	// ClientID of the GitHub OAuth app.
+	ClientID string `json:"clientId"`
+}
+
+// GitHubAuthConfigParameters are the configurable fields of a GitHubAuthConfig.
+type GitHubAuthConfigParameters struct {
+	GitHubConfig     `json:",inline"`
+	AuthConfigAccess `json:",inline"`
+	// ClientSecretSecretRef selects the Secret key holding the client secret
+	// of the GitHub OAuth app.
+	ClientSecretSecretRef xpv1.SecretKeySelector `json:"clientSecretSecretRef"`
+}
+
+// GitHubAuthConfigObservation are the observable fields of a GitHubAuthConfig.
+type GitHubAuthConfigObservation struct {
+	// Enabled reports whether the provider is enabled in Rancher.
+	Enabled bool `json:"enabled,omitempty"`
+	// SecretVersion is the resource version of the referenced Secrets the
+	// provider was last configured from.
+	SecretVersion string `json:"secretVersion,omitempty"`
+}
+
+// A GitHubAuthConfigSpec defines the desired state of a GitHubAuthConfig.
+type GitHubAuthConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GitHubAuthConfigParameters `json:"forProvider"`
+}
+
+// A GitHubAuthConfigStatus represents the observed state of a GitHubAuthConfig.
+type GitHubAuthConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GitHubAuthConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GitHubAuthConfig configures and enables the GitHub authentication provider
+// of Rancher. There is a single GitHub provider per Rancher installation.
+// The configuration is validated with the configureTest action and then
+// enabled. Rancher's testAndApply action is not used since it requires an
+// authorization code from an interactive GitHub login.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type GitHubAuthConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GitHubAuthConfigSpec   `json:"spec"`
+	Status GitHubAuthConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GitHubAuthConfigList contains a list of GitHubAuthConfig
+type GitHubAuthConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitHubAuthConfig `json:"items"`
+}
+
+// GitHubAuthConfig type metadata.
+var (
+	GitHubAuthConfigKind             = reflect.TypeOf(GitHubAuthConfig{}).Name()
+	GitHubAuthConfigGroupKind        = schema.GroupKind{Group: Group, Kind: GitHubAuthConfigKind}.String()
+	GitHubAuthConfigKindAPIVersion   = GitHubAuthConfigKind + "." + SchemeGroupVersion.String()
+	GitHubAuthConfigGroupVersionKind = SchemeGroupVersion.WithKind(GitHubAuthConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GitHubAuthConfig{}, &GitHubAuthConfigList{})
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GlobalRoleParameters are the configurable fields of a GlobalRole.
+type GlobalRoleParameters struct {
+	Description string `json:"description,omitempty"`
+	// Rules are the Kubernetes RBAC rules granted by the global role.
+	Rules []rbacv1.PolicyRule `json:"rules,omitempty"`
+	// NewUserDefault grants the global role to new users.
+	NewUserDefault bool `json:"newUserDefault,omitempty"`
+}
+
+// GlobalRoleObservation are the observable fields of a GlobalRole.
+type GlobalRoleObservation struct {
+	ID      string `json:"id,omitempty"`
+	Builtin bool   `json:"builtin,omitempty"`
+}
+
+// A GlobalRoleSpec defines the desired state of a GlobalRole.
+type GlobalRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GlobalRoleParameters `json:"forProvider"`
+}
+
+// A GlobalRoleStatus represents the observed state of a GlobalRole.
+type GlobalRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GlobalRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GlobalRole is a Rancher global role.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type GlobalRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GlobalRoleSpec   `json:"spec"`
+	Status GlobalRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GlobalRoleList contains a list of GlobalRole
+type GlobalRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GlobalRole `json:"items"`
+}
+
+// GlobalRole type metadata.
+var (
+	GlobalRoleKind             = reflect.TypeOf(GlobalRole{}).Name()
+	GlobalRoleGroupKind        = schema.GroupKind{Group: Group, Kind: GlobalRoleKind}.String()
+	GlobalRoleKindAPIVersion   = GlobalRoleKind + "." + SchemeGroupVersion.String()
+	GlobalRoleGroupVersionKind = SchemeGroupVersion.WithKind(GlobalRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GlobalRole{}, &GlobalRoleList{})
+}
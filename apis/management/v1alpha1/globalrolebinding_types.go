@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GlobalRoleBindingParameters are the configurable fields of a GlobalRoleBinding.
+type GlobalRoleBindingParameters struct {
+	// GlobalRoleID is the ID of the global role to grant, e.g. admin or
+	// user.
+	GlobalRoleID string `json:"globalRoleId"`
+
+	Subject `json:",inline"`
+}
+
+// GlobalRoleBindingObservation are the observable fields of a GlobalRoleBinding.
+type GlobalRoleBindingObservation struct {
+	ID               string `json:"id,omitempty"`
+	UserID           string `json:"userId,omitempty"`
+	GroupPrincipalID string `json:"groupPrincipalId,omitempty"`
+}
+
+// A GlobalRoleBindingSpec defines the desired state of a GlobalRoleBinding.
+type GlobalRoleBindingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GlobalRoleBindingParameters `json:"forProvider"`
+}
+
+// A GlobalRoleBindingStatus represents the observed state of a GlobalRoleBinding.
+type GlobalRoleBindingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GlobalRoleBindingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GlobalRoleBinding grants a global role to a user or group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type GlobalRoleBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GlobalRoleBindingSpec   `json:"spec"`
+	Status GlobalRoleBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GlobalRoleBindingList contains a list of GlobalRoleBinding
+type GlobalRoleBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GlobalRoleBinding `json:"items"`
+}
+
+// GlobalRoleBinding type metadata.
+var (
+	GlobalRoleBindingKind             = reflect.TypeOf(GlobalRoleBinding{}).Name()
+	GlobalRoleBindingGroupKind        = schema.GroupKind{Group: Group, Kind: GlobalRoleBindingKind}.String()
+	GlobalRoleBindingKindAPIVersion   = GlobalRoleBindingKind + "." + SchemeGroupVersion.String()
+	GlobalRoleBindingGroupVersionKind = SchemeGroupVersion.WithKind(GlobalRoleBindingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GlobalRoleBinding{}, &GlobalRoleBindingList{})
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KeyCloakOIDCConfig are the settings of the Keycloak OIDC provider.
+type KeyCloakOIDCConfig struct {
+	ClientID string `json:"clientId"`
+	// Issuer is the URL of the Keycloak realm, e.g.
+	// https://keycloak.example.com/auth/realms/rancher.
+	Issuer       string `json:"issuer"`
+	AuthEndpoint string `json:"authEndpoint"`
+	// RancherURL is the redirect URL of Rancher, usually
+	// https://<rancher>/verify-auth.
+	RancherURL string `json:"rancherUrl"`
+	Scope      string `json:"scope,omitempty"`
+	// Certificate is the PEM encoded certificate used to sign requests.
+	Certificate string `json:"certificate,omitempty"`
+}
+
+// KeyCloakOIDCAuthConfigParameters are the configurable fields of a KeyCloakOIDCAuthConfig.
+type KeyCloakOIDCAuthConfigParameters struct {
+	KeyCloakOIDCConfig `json:",inline"`
+	AuthConfigAccess   `json:",inline"`
+	// ClientSecretSecretRef selects the Secret key holding the client secret
+	// of the Keycloak client.
+	ClientSecretSecretRef xpv1.SecretKeySelector `json:"clientSecretSecretRef"`
+	// PrivateKeySecretRef optionally selects the Secret key holding the PEM
+	// encoded private key matching Certificate.
+	PrivateKeySecretRef *xpv1.SecretKeySelector `json:"privateKeySecretRef,omitempty"`
+}
+
+// KeyCloakOIDCAuthConfigObservation are the observable fields of a KeyCloakOIDCAuthConfig.
+type KeyCloakOIDCAuthConfigObservation struct {
+	// Enabled reports whether the provider is enabled in Rancher.
+	Enabled bool `json:"enabled,omitempty"`
+	// SecretVersion is the resource version of the referenced Secrets the
+	// provider was last configured from.
+	SecretVersion string `json:"secretVersion,omitempty"`
+}
+
+// A KeyCloakOIDCAuthConfigSpec defines the desired state of a KeyCloakOIDCAuthConfig.
+type KeyCloakOIDCAuthConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyCloakOIDCAuthConfigParameters `json:"forProvider"`
+}
+
+// A KeyCloakOIDCAuthConfigStatus represents the observed state of a KeyCloakOIDCAuthConfig.
+type KeyCloakOIDCAuthConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyCloakOIDCAuthConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyCloakOIDCAuthConfig configures and enables the Keycloak OIDC
+// authentication provider of Rancher.
+// The configuration is validated with the configureTest action and then
+// enabled. Rancher's testAndApply action is not used since it requires an
+// authorization code from an interactive Keycloak login.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type KeyCloakOIDCAuthConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyCloakOIDCAuthConfigSpec   `json:"spec"`
+	Status KeyCloakOIDCAuthConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyCloakOIDCAuthConfigList contains a list of KeyCloakOIDCAuthConfig
+type KeyCloakOIDCAuthConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyCloakOIDCAuthConfig `json:"items"`
+}
+
+// KeyCloakOIDCAuthConfig type metadata.
+var (
+	KeyCloakOIDCAuthConfigKind             = reflect.TypeOf(KeyCloakOIDCAuthConfig{}).Name()
+	KeyCloakOIDCAuthConfigGroupKind        = schema.GroupKind{Group: Group, Kind: KeyCloakOIDCAuthConfigKind}.String()
+	KeyCloakOIDCAuthConfigKindAPIVersion   = KeyCloakOIDCAuthConfigKind + "." + SchemeGroupVersion.String()
+	KeyCloakOIDCAuthConfigGroupVersionKind = SchemeGroupVersion.WithKind(KeyCloakOIDCAuthConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KeyCloakOIDCAuthConfig{}, &KeyCloakOIDCAuthConfigList{})
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OpenLDAPConfig are the settings of the OpenLDAP provider.
+type OpenLDAPConfig struct {
+	LDAPConfig                      `json:",inline"`
+	ServiceAccountDistinguishedName string `json:"serviceAccountDistinguishedName"`
+	UserMemberAttribute             string `json:"userMemberAttribute,omitempty"`
+}
+
+// OpenLDAPAuthConfigParameters are the configurable fields of a OpenLDAPAuthConfig.
+type OpenLDAPAuthConfigParameters struct {
+	OpenLDAPConfig      `json:",inline"`
+	AuthConfigAccess    `json:",inline"`
+	LDAPTestCredentials `json:",inline"`
+	// ServiceAccountPasswordSecretRef selects the Secret key holding the
+	// password of the service account.
+	ServiceAccountPasswordSecretRef xpv1.SecretKeySelector `json:"serviceAccountPasswordSecretRef"`
+}
+
+// OpenLDAPAuthConfigObservation are the observable fields of a OpenLDAPAuthConfig.
+type OpenLDAPAuthConfigObservation struct {
+	// Enabled reports whether the provider is enabled in Rancher.
+	Enabled bool `json:"enabled,omitempty"`
+	// SecretVersion is the resource version of the referenced Secrets the
+	// provider was last configured from.
+	SecretVersion string `json:"secretVersion,omitempty"`
+}
+
+// A OpenLDAPAuthConfigSpec defines the desired state of a OpenLDAPAuthConfig.
+type OpenLDAPAuthConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OpenLDAPAuthConfigParameters `json:"forProvider"`
+}
+
+// A OpenLDAPAuthConfigStatus represents the observed state of a OpenLDAPAuthConfig.
+type OpenLDAPAuthConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OpenLDAPAuthConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OpenLDAPAuthConfig configures and enables the OpenLDAP authentication
+// provider of Rancher.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type OpenLDAPAuthConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenLDAPAuthConfigSpec   `json:"spec"`
+	Status OpenLDAPAuthConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenLDAPAuthConfigList contains a list of OpenLDAPAuthConfig
+type OpenLDAPAuthConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenLDAPAuthConfig `json:"items"`
+}
+
+// OpenLDAPAuthConfig type metadata.
+var (
+	OpenLDAPAuthConfigKind             = reflect.TypeOf(OpenLDAPAuthConfig{}).Name()
+	OpenLDAPAuthConfigGroupKind        = schema.GroupKind{Group: Group, Kind: OpenLDAPAuthConfigKind}.String()
+	OpenLDAPAuthConfigKindAPIVersion   = OpenLDAPAuthConfigKind + "." + SchemeGroupVersion.String()
+	OpenLDAPAuthConfigGroupVersionKind = SchemeGroupVersion.WithKind(OpenLDAPAuthConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OpenLDAPAuthConfig{}, &OpenLDAPAuthConfigList{})
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ResourceQuotaLimit holds the resource limits of a project or namespace quota.
+type ResourceQuotaLimit struct {
+	Pods                   string `json:"pods,omitempty"`
+	Services               string `json:"services,omitempty"`
+	ReplicationControllers string `json:"replicationControllers,omitempty"`
+	Secrets                string `json:"secrets,omitempty"`
+	ConfigMaps             string `json:"configMaps,omitempty"`
+	PersistentVolumeClaims string `json:"persistentVolumeClaims,omitempty"`
+	ServicesNodePorts      string `json:"servicesNodePorts,omitempty"`
+	ServicesLoadBalancers  string `json:"servicesLoadBalancers,omitempty"`
+	RequestsCPU            string `json:"requestsCpu,omitempty"`
+	RequestsMemory         string `json:"requestsMemory,omitempty"`
+	RequestsStorage        string `json:"requestsStorage,omitempty"`
+	LimitsCPU              string `json:"limitsCpu,omitempty"`
+	LimitsMemory           string `json:"limitsMemory,omitempty"`
+}
+
+// ProjectResourceQuota is the quota shared by all namespaces of a project.
+type ProjectResourceQuota struct {
+	Limit ResourceQuotaLimit `json:"limit"`
+}
+
+// NamespaceResourceQuota is the quota applied to a single namespace.
+type NamespaceResourceQuota struct {
+	Limit ResourceQuotaLimit `json:"limit"`
+}
+
+// ContainerResourceLimit is the default resource limit of containers that
+// do not specify their own.
+type ContainerResourceLimit struct {
+	RequestsCPU    string `json:"requestsCpu,omitempty"`
+	RequestsMemory string `json:"requestsMemory,omitempty"`
+	LimitsCPU      string `json:"limitsCpu,omitempty"`
+	LimitsMemory   string `json:"limitsMemory,omitempty"`
+}
+
+// ProjectParameters are the configurable fields of a Project.
+type ProjectParameters struct {
+	// ClusterID is the Rancher ID of the cluster owning the project.
+	ClusterID string `json:"clusterId,omitempty"`
+	// ClusterIDRef is the name of the cluster owning the project, e.g. the
+	// name of a RKE1Cluster. It is resolved to ClusterID.
+	ClusterIDRef string `json:"clusterIdRef,omitempty"`
+
+	Description                   string                  `json:"description,omitempty"`
+	Labels                        map[string]string       `json:"labels,omitempty"`
+	Annotations                   map[string]string       `json:"annotations,omitempty"`
+	ResourceQuota                 *ProjectResourceQuota   `json:"resourceQuota,omitempty"`
+	NamespaceDefaultResourceQuota *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty"`
+	ContainerDefaultResourceLimit *ContainerResourceLimit `json:"containerDefaultResourceLimit,omitempty"`
+	// PodSecurityPolicyTemplateID is the PodSecurityPolicy template bound to
+	// the project. Pod Security Admission templates are configured on the
+	// cluster instead.
+	PodSecurityPolicyTemplateID string `json:"podSecurityPolicyTemplateId,omitempty"`
+}
+
+// ProjectObservation are the observable fields of a Project.
+type ProjectObservation struct {
+	ID        string `json:"id,omitempty"`
+	ClusterID string `json:"clusterId,omitempty"`
+	State     string `json:"state,omitempty"`
+}
+
+// A ProjectSpec defines the desired state of a Project.
+type ProjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectParameters `json:"forProvider"`
+}
+
+// A ProjectStatus represents the observed state of a Project.
+type ProjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Project is a Rancher project grouping namespaces of a cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type Project struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectSpec   `json:"spec"`
+	Status ProjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectList contains a list of Project
+type ProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Project `json:"items"`
+}
+
+// Project type metadata.
+var (
+	ProjectKind             = reflect.TypeOf(Project{}).Name()
+	ProjectGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectKind}.String()
+	ProjectKindAPIVersion   = ProjectKind + "." + SchemeGroupVersion.String()
+	ProjectGroupVersionKind = SchemeGroupVersion.WithKind(ProjectKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Project{}, &ProjectList{})
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ProjectRoleTemplateBindingParameters are the configurable fields of a ProjectRoleTemplateBinding.
+type ProjectRoleTemplateBindingParameters struct {
+	// ProjectID is the Rancher ID of the project the role is granted on, in
+	// the form c-xxxxx:p-xxxxx.
+	ProjectID string `json:"projectId,omitempty"`
+	// ProjectIDRef is the name of a Project managed resource. It is resolved
+	// to ProjectID once the Project has been created.
+	ProjectIDRef string `json:"projectIdRef,omitempty"`
+
+	// RoleTemplateID is the ID of the project role template to grant, e.g.
+	// project-owner or project-member.
+	RoleTemplateID string `json:"roleTemplateId,omitempty"`
+	// RoleTemplateIDRef is the name of a RoleTemplate managed resource. It
+	// is resolved to RoleTemplateID once the RoleTemplate has been created.
+	RoleTemplateIDRef string `json:"roleTemplateIdRef,omitempty"`
+
+	Subject `json:",inline"`
+}
+
+// ProjectRoleTemplateBindingObservation are the observable fields of a ProjectRoleTemplateBinding.
+type ProjectRoleTemplateBindingObservation struct {
+	ID               string `json:"id,omitempty"`
+	ProjectID        string `json:"projectId,omitempty"`
+	UserID           string `json:"userId,omitempty"`
+	GroupPrincipalID string `json:"groupPrincipalId,omitempty"`
+}
+
+// A ProjectRoleTemplateBindingSpec defines the desired state of a ProjectRoleTemplateBinding.
+type ProjectRoleTemplateBindingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectRoleTemplateBindingParameters `json:"forProvider"`
+}
+
+// A ProjectRoleTemplateBindingStatus represents the observed state of a ProjectRoleTemplateBinding.
+type ProjectRoleTemplateBindingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectRoleTemplateBindingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectRoleTemplateBinding grants a role template to a user or group on a
+// project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type ProjectRoleTemplateBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectRoleTemplateBindingSpec   `json:"spec"`
+	Status ProjectRoleTemplateBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectRoleTemplateBindingList contains a list of ProjectRoleTemplateBinding
+type ProjectRoleTemplateBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectRoleTemplateBinding `json:"items"`
+}
+
+// ProjectRoleTemplateBinding type metadata.
+var (
+	ProjectRoleTemplateBindingKind             = reflect.TypeOf(ProjectRoleTemplateBinding{}).Name()
+	ProjectRoleTemplateBindingGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectRoleTemplateBindingKind}.String()
+	ProjectRoleTemplateBindingKindAPIVersion   = ProjectRoleTemplateBindingKind + "." + SchemeGroupVersion.String()
+	ProjectRoleTemplateBindingGroupVersionKind = SchemeGroupVersion.WithKind(ProjectRoleTemplateBindingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProjectRoleTemplateBinding{}, &ProjectRoleTemplateBindingList{})
+}
@@ -0,0 +1,138 @@
+package v1alpha1
+
+import rbacv1 "k8s.io/api/rbac/v1"
+
+type ProjectResponse struct {
+	Data []ProjectData `json:"data"`
+}
+
+type ProjectData struct {
+	ID                            string                  `json:"id,omitempty"`
+	Name                          string                  `json:"name"`
+	ClusterID                     string                  `json:"clusterId"`
+	State                         string                  `json:"state,omitempty"`
+	Description                   string                  `json:"description,omitempty"`
+	Labels                        map[string]string       `json:"labels,omitempty"`
+	Annotations                   map[string]string       `json:"annotations,omitempty"`
+	ResourceQuota                 *ProjectResourceQuota   `json:"resourceQuota,omitempty"`
+	NamespaceDefaultResourceQuota *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty"`
+	ContainerDefaultResourceLimit *ContainerResourceLimit `json:"containerDefaultResourceLimit,omitempty"`
+	PodSecurityPolicyTemplateID   string                  `json:"podSecurityPolicyTemplateId,omitempty"`
+}
+
+type RoleBindingResponse struct {
+	Data []RoleBindingData `json:"data"`
+}
+
+// RoleBindingData is a cluster, project or global role binding.
+type RoleBindingData struct {
+	ID               string `json:"id,omitempty"`
+	ClusterID        string `json:"clusterId,omitempty"`
+	ProjectID        string `json:"projectId,omitempty"`
+	RoleTemplateID   string `json:"roleTemplateId,omitempty"`
+	GlobalRoleID     string `json:"globalRoleId,omitempty"`
+	UserID           string `json:"userId,omitempty"`
+	UserPrincipalID  string `json:"userPrincipalId,omitempty"`
+	GroupPrincipalID string `json:"groupPrincipalId,omitempty"`
+}
+
+type UserResponse struct {
+	Data []UserData `json:"data"`
+}
+
+// UserData is a Rancher user. The password is only sent on creation.
+type UserData struct {
+	ID                 string   `json:"id,omitempty"`
+	Username           string   `json:"username"`
+	Name               string   `json:"name,omitempty"`
+	Description        string   `json:"description,omitempty"`
+	Password           string   `json:"password,omitempty"`
+	MustChangePassword bool     `json:"mustChangePassword"`
+	Enabled            *bool    `json:"enabled,omitempty"`
+	PrincipalIDs       []string `json:"principalIds,omitempty"`
+}
+
+type PrincipalResponse struct {
+	Data []PrincipalData `json:"data"`
+}
+
+type PrincipalData struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	LoginName     string `json:"loginName"`
+	PrincipalType string `json:"principalType"`
+}
+
+type RoleTemplateResponse struct {
+	Data []RoleTemplateData `json:"data"`
+}
+
+// RoleTemplateData is a Rancher role template. Boolean fields are always
+// sent so that they can be reset to false.
+type RoleTemplateData struct {
+	ID                    string              `json:"id,omitempty"`
+	Name                  string              `json:"name"`
+	Description           string              `json:"description,omitempty"`
+	Context               string              `json:"context"`
+	Rules                 []rbacv1.PolicyRule `json:"rules,omitempty"`
+	RoleTemplateIDs       []string            `json:"roleTemplateIds,omitempty"`
+	Locked                bool                `json:"locked"`
+	ClusterCreatorDefault bool                `json:"clusterCreatorDefault"`
+	ProjectCreatorDefault bool                `json:"projectCreatorDefault"`
+	Administrative        bool                `json:"administrative"`
+	Hidden                bool                `json:"hidden"`
+	Builtin               bool                `json:"builtin,omitempty"`
+}
+
+type GlobalRoleResponse struct {
+	Data []GlobalRoleData `json:"data"`
+}
+
+// GlobalRoleData is a Rancher global role.
+type GlobalRoleData struct {
+	ID             string              `json:"id,omitempty"`
+	Name           string              `json:"name"`
+	Description    string              `json:"description,omitempty"`
+	Rules          []rbacv1.PolicyRule `json:"rules,omitempty"`
+	NewUserDefault bool                `json:"newUserDefault"`
+	Builtin        bool                `json:"builtin,omitempty"`
+}
+
+// TokenData is a Rancher API token. Token is only returned on creation.
+type TokenData struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ClusterID   string `json:"clusterId,omitempty"`
+	TTLMillis   int64  `json:"ttl,omitempty"`
+	Token       string `json:"token,omitempty"`
+	ExpiresAt   string `json:"expiresAt,omitempty"`
+	Expired     bool   `json:"expired,omitempty"`
+}
+
+// SettingData is a Rancher server setting. An empty value selects the
+// default.
+type SettingData struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	Value      string `json:"value"`
+	Default    string `json:"default,omitempty"`
+	Customized bool   `json:"customized,omitempty"`
+	Source     string `json:"source,omitempty"`
+}
+
+// FeatureData is a Rancher feature flag. A nil value selects the default.
+type FeatureData struct {
+	ID     string             `json:"id,omitempty"`
+	Name   string             `json:"name"`
+	Value  *bool              `json:"value"`
+	Status *FeatureDataStatus `json:"status,omitempty"`
+}
+
+// FeatureDataStatus is the status of a Rancher feature flag.
+type FeatureDataStatus struct {
+	Default     bool   `json:"default"`
+	Dynamic     bool   `json:"dynamic"`
+	LockedValue *bool  `json:"lockedValue,omitempty"`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RoleTemplateParameters are the configurable fields of a RoleTemplate.
+type RoleTemplateParameters struct {
+	Description string `json:"description,omitempty"`
+	// Context is the scope the role template applies to.
+	// +kubebuilder:validation:Enum=cluster;project
+	Context string `json:"context"`
+	// Rules are the Kubernetes RBAC rules granted by the role template.
+	Rules []rbacv1.PolicyRule `json:"rules,omitempty"`
+	// RoleTemplateIDs are the IDs of role templates whose rules are
+	// inherited, e.g. project-member.
+	RoleTemplateIDs []string `json:"roleTemplateIds,omitempty"`
+	// RoleTemplateIDRefs are the names of RoleTemplate managed resources
+	// whose rules are inherited. They are resolved to RoleTemplateIDs.
+	RoleTemplateIDRefs []string `json:"roleTemplateIdRefs,omitempty"`
+	// Locked prevents the role template from being used in new bindings.
+	Locked bool `json:"locked,omitempty"`
+	// ClusterCreatorDefault grants the role template to the creator of new
+	// clusters.
+	ClusterCreatorDefault bool `json:"clusterCreatorDefault,omitempty"`
+	// ProjectCreatorDefault grants the role template to the creator of new
+	// projects.
+	ProjectCreatorDefault bool `json:"projectCreatorDefault,omitempty"`
+	Administrative        bool `json:"administrative,omitempty"`
+	Hidden                bool `json:"hidden,omitempty"`
+}
+
+// RoleTemplateObservation are the observable fields of a RoleTemplate.
+type RoleTemplateObservation struct {
+	ID      string `json:"id,omitempty"`
+	Builtin bool   `json:"builtin,omitempty"`
+}
+
+// A RoleTemplateSpec defines the desired state of a RoleTemplate.
+type RoleTemplateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RoleTemplateParameters `json:"forProvider"`
+}
+
+// A RoleTemplateStatus represents the observed state of a RoleTemplate.
+type RoleTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RoleTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RoleTemplate is a Rancher cluster or project role.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type RoleTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RoleTemplateSpec   `json:"spec"`
+	Status RoleTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RoleTemplateList contains a list of RoleTemplate
+type RoleTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RoleTemplate `json:"items"`
+}
+
+// RoleTemplate type metadata.
+var (
+	RoleTemplateKind             = reflect.TypeOf(RoleTemplate{}).Name()
+	RoleTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: RoleTemplateKind}.String()
+	RoleTemplateKindAPIVersion   = RoleTemplateKind + "." + SchemeGroupVersion.String()
+	RoleTemplateGroupVersionKind = SchemeGroupVersion.WithKind(RoleTemplateKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RoleTemplate{}, &RoleTemplateList{})
+}
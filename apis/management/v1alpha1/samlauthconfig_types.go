@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SAMLConfig are the settings shared by the SAML providers.
+type SAMLConfig struct {
+	// IDPMetadataContent is the XML metadata of the identity provider.
+	IDPMetadataContent string `json:"idpMetadataContent"`
+	// SPCert is the PEM encoded certificate of the Rancher service provider.
+	SPCert string `json:"spCert"`
+	// RancherAPIHost is the URL of Rancher, e.g. https://rancher.example.com.
+	RancherAPIHost   string `json:"rancherApiHost"`
+	DisplayNameField string `json:"displayNameField"`
+	UserNameField    string `json:"userNameField"`
+	UIDField         string `json:"uidField"`
+	GroupsField      string `json:"groupsField,omitempty"`
+	// EntityID is the entity ID of the service provider. Only used by the
+	// keycloak provider.
+	EntityID string `json:"entityID,omitempty"`
+}
+
+// SAMLAuthConfigParameters are the configurable fields of a SAMLAuthConfig.
+type SAMLAuthConfigParameters struct {
+	// Provider is the SAML provider to configure.
+	// +kubebuilder:validation:Enum=keycloak;ping;adfs;okta;shibboleth
+	Provider         string `json:"provider"`
+	SAMLConfig       `json:",inline"`
+	AuthConfigAccess `json:",inline"`
+	// SPKeySecretRef selects the Secret key holding the PEM encoded private
+	// key of the Rancher service provider.
+	SPKeySecretRef xpv1.SecretKeySelector `json:"spKeySecretRef"`
+}
+
+// SAMLAuthConfigObservation are the observable fields of a SAMLAuthConfig.
+type SAMLAuthConfigObservation struct {
+	// Enabled reports whether the provider is enabled in Rancher.
+	Enabled bool `json:"enabled,omitempty"`
+	// SecretVersion is the resource version of the referenced Secrets the
+	// provider was last configured from.
+	SecretVersion string `json:"secretVersion,omitempty"`
+}
+
+// A SAMLAuthConfigSpec defines the desired state of a SAMLAuthConfig.
+type SAMLAuthConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SAMLAuthConfigParameters `json:"forProvider"`
+}
+
+// A SAMLAuthConfigStatus represents the observed state of a SAMLAuthConfig.
+type SAMLAuthConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SAMLAuthConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SAMLAuthConfig configures and enables one of the SAML authentication
+// providers of Rancher.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type SAMLAuthConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SAMLAuthConfigSpec   `json:"spec"`
+	Status SAMLAuthConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SAMLAuthConfigList contains a list of SAMLAuthConfig
+type SAMLAuthConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SAMLAuthConfig `json:"items"`
+}
+
+// SAMLAuthConfig type metadata.
+var (
+	SAMLAuthConfigKind             = reflect.TypeOf(SAMLAuthConfig{}).Name()
+	SAMLAuthConfigGroupKind        = schema.GroupKind{Group: Group, Kind: SAMLAuthConfigKind}.String()
+	SAMLAuthConfigKindAPIVersion   = SAMLAuthConfigKind + "." + SchemeGroupVersion.String()
+	SAMLAuthConfigGroupVersionKind = SchemeGroupVersion.WithKind(SAMLAuthConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SAMLAuthConfig{}, &SAMLAuthConfigList{})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SettingParameters are the configurable fields of a Setting.
+type SettingParameters struct {
+	// Name of the setting. Defaults to the name of the managed resource.
+	Name string `json:"name,omitempty"`
+	// Value of the setting. An empty value selects the default.
+	Value string `json:"value"`
+}
+
+// SettingObservation are the observable fields of a Setting.
+type SettingObservation struct {
+	// Value is the effective value of the setting.
+	Value   string `json:"value,omitempty"`
+	Default string `json:"default,omitempty"`
+	// Customized reports whether the effective value differs from the
+	// default.
+	Customized bool `json:"customized,omitempty"`
+	// Source is where the value comes from, e.g. db or env. Values set by
+	// environment variables cannot be changed through the API.
+	Source string `json:"source,omitempty"`
+}
+
+// A SettingSpec defines the desired state of a Setting.
+type SettingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SettingParameters `json:"forProvider"`
+}
+
+// A SettingStatus represents the observed state of a Setting.
+type SettingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SettingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Setting is a Rancher server setting, e.g. server-url. Deleting the
+// Setting resets the value to its default, unless the deletion policy is
+// Orphan.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VALUE",type="string",JSONPath=".status.atProvider.value"
+// +kubebuilder:printcolumn:name="CUSTOMIZED",type="boolean",JSONPath=".status.atProvider.customized"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type Setting struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SettingSpec   `json:"spec"`
+	Status SettingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SettingList contains a list of Setting
+type SettingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Setting `json:"items"`
+}
+
+// Setting type metadata.
+var (
+	SettingKind             = reflect.TypeOf(Setting{}).Name()
+	SettingGroupKind        = schema.GroupKind{Group: Group, Kind: SettingKind}.String()
+	SettingKindAPIVersion   = SettingKind + "." + SchemeGroupVersion.String()
+	SettingGroupVersionKind = SchemeGroupVersion.WithKind(SettingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Setting{}, &SettingList{})
+}
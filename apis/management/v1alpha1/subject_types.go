@@ -0,0 +1,36 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// A Subject identifies the user or group a binding grants access to. Exactly
+// one of the fields should be set.
+type Subject struct {
+	// UserID is the Rancher ID of a user, e.g. u-xxxxx.
+	UserID string `json:"userId,omitempty"`
+	// UserName is the username of a local Rancher user. It is resolved to
+	// UserID.
+	UserName string `json:"userName,omitempty"`
+	// UserPrincipalID is the principal ID of a user from an authentication
+	// provider, e.g. github_user://1234.
+	UserPrincipalID string `json:"userPrincipalId,omitempty"`
+	// GroupPrincipalID is the principal ID of a group from an authentication
+	// provider, e.g. github_org://1234.
+	GroupPrincipalID string `json:"groupPrincipalId,omitempty"`
+	// GroupName is the name of a group known to the active authentication
+	// provider. It is resolved to GroupPrincipalID.
+	GroupName string `json:"groupName,omitempty"`
+}
@@ -20,7 +20,12 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	catalogv1alpha1 "github.com/dormullor/provider-rancher/apis/catalog/v1alpha1"
+	fleetv1alpha1 "github.com/dormullor/provider-rancher/apis/fleet/v1alpha1"
+	hostedv1alpha1 "github.com/dormullor/provider-rancher/apis/hosted/v1alpha1"
+	managementv1alpha1 "github.com/dormullor/provider-rancher/apis/management/v1alpha1"
 	rancherclusterv1alpha1 "github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	rancherclusterv1beta1 "github.com/dormullor/provider-rancher/apis/rke1/v1beta1"
 	rancherv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
 )
 
@@ -29,6 +34,11 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		rancherv1alpha1.SchemeBuilder.AddToScheme,
 		rancherclusterv1alpha1.SchemeBuilder.AddToScheme,
+		rancherclusterv1beta1.SchemeBuilder.AddToScheme,
+		hostedv1alpha1.SchemeBuilder.AddToScheme,
+		managementv1alpha1.SchemeBuilder.AddToScheme,
+		catalogv1alpha1.SchemeBuilder.AddToScheme,
+		fleetv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -17,9 +17,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
 	"reflect"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -59,6 +61,10 @@ type RKEClusterConfigSpec struct {
 	Labels                   map[string]string             `json:"labels,omitempty"`
 	LocalClusterAuthEndpoint LocalClusterAuthEndpoint      `json:"localClusterAuthEndpoint,omitempty"`
 	Name                     string                        `json:"name,omitempty"`
+	// DefaultPodSecurityAdmissionConfigurationTemplateName is the Pod
+	// Security Admission template applied to the cluster, e.g.
+	// rancher-restricted.
+	DefaultPodSecurityAdmissionConfigurationTemplateName string `json:"defaultPodSecurityAdmissionConfigurationTemplateName,omitempty"`
 }
 
 // ClusterParameters are the configurable fields of a Cluster.
@@ -66,12 +72,248 @@ type ClusterParameters struct {
 	KubeconfigSecretNamespace string               `json:"kubeconfigSecretNamespace,omitempty"`
 	Region                    string               `json:"region,omitempty"`
 	RKE                       RKEClusterConfigSpec `json:"rke,omitempty"`
-	NodePools                 []RKENodePool        `json:"nodePools,omitempty"`
+	// NodePools provision the nodes of the cluster with node templates. A
+	// cluster without node pools is a custom cluster: its nodes register by
+	// running the node commands published as connection details. Pools are
+	// identified by their hostname prefix; pools missing in Rancher are
+	// created whenever the cluster is reconciled.
+	NodePools []RKENodePool `json:"nodePools,omitempty"`
+
+	// ClusterTemplateRevisionID is the Rancher ID of the cluster template
+	// revision the cluster is created from. RKE is ignored when set.
+	ClusterTemplateRevisionID string `json:"clusterTemplateRevisionId,omitempty"`
+	// ClusterTemplateRevisionRef is the name of a ClusterTemplateRevision. It
+	// is resolved to ClusterTemplateRevisionID.
+	ClusterTemplateRevisionRef string `json:"clusterTemplateRevisionRef,omitempty"`
+	// Answers to the questions of the cluster template revision, keyed by
+	// variable.
+	Answers map[string]string `json:"answers,omitempty"`
+
+	// CertificateRotation rotates the certificates of the cluster whenever its
+	// generation is increased.
+	CertificateRotation *CertificateRotation `json:"certificateRotation,omitempty"`
+
+	// Upgrade configures how changes of the Kubernetes version in RKE are
+	// rolled out.
+	Upgrade *UpgradePolicy `json:"upgrade,omitempty"`
+
+	// NodeMaintenance cordons, drains or deletes individual nodes of the
+	// cluster. Nodes that are not listed are left as they are.
+	NodeMaintenance []NodeMaintenance `json:"nodeMaintenance,omitempty"`
+
+	// DeletionProtection refuses to delete the cluster from Rancher while
+	// true. Setting the deletion-protection annotation to "true" has the
+	// same effect.
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+	// Deletion configures how the cluster is deleted from Rancher.
+	Deletion *DeletionPolicy `json:"deletion,omitempty"`
+}
+
+// DeletionPolicy configures how a cluster is deleted from Rancher.
+type DeletionPolicy struct {
+	// FinalSnapshot takes an etcd snapshot of the cluster and waits for it to
+	// complete before the cluster is deleted. The snapshot only outlives the
+	// cluster when etcd backups are stored in S3.
+	FinalSnapshot bool `json:"finalSnapshot,omitempty"`
+	// Force deletes the cluster even though it still runs workloads outside
+	// of the system namespaces, or when its workloads cannot be listed.
+	// Workloads are only checked while the cluster is active: clusters that
+	// are not, e.g. because their agent is down, are deleted without force.
+	Force bool `json:"force,omitempty"`
+}
+
+// NodeMaintenance is the desired state of an individual node of a cluster.
+type NodeMaintenance struct {
+	// Node is the name, hostname or Rancher ID of the node. Delete only
+	// matches the Rancher ID, so that a node replacing a deleted node is not
+	// deleted as well.
+	// +kubebuilder:validation:MinLength=1
+	Node string `json:"node"`
+	// Cordon marks the node unschedulable. The node is uncordoned when
+	// neither Cordon nor Drain is set.
+	Cordon bool `json:"cordon,omitempty"`
+	// Drain cordons the node and evicts its pods with the supplied options.
+	Drain *NodeDrainInput `json:"drain,omitempty"`
+	// Delete removes the node from the cluster. Rancher replaces a deleted
+	// node of a node pool with a new node.
+	Delete bool `json:"delete,omitempty"`
+}
+
+// UpgradePolicy configures how changes of the Kubernetes version of a cluster
+// are rolled out. The nodes are upgraded following the upgradeStrategy of the
+// RKE configuration.
+type UpgradePolicy struct {
+	// SnapshotBeforeUpgrade takes an etcd snapshot of the cluster and waits
+	// for it to complete before the upgrade starts.
+	SnapshotBeforeUpgrade bool `json:"snapshotBeforeUpgrade,omitempty"`
+	// Timeout after which an upgrade that has not completed is marked with
+	// the UpgradeFailed condition. Defaults to 1h.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// CertificateRotation is a request to rotate the certificates of a cluster.
+type CertificateRotation struct {
+	// Generation of the rotation. The certificates are rotated when it is
+	// greater than the generation last rotated; the generation set when the
+	// cluster is created does not rotate.
+	// +kubebuilder:validation:Minimum=0
+	Generation int64 `json:"generation"`
+	// CACertificates also rotates the CA certificates. This restarts all
+	// services of the cluster.
+	CACertificates bool `json:"caCertificates,omitempty"`
+	// Service whose certificates are rotated. All services are rotated when
+	// empty.
+	// +kubebuilder:validation:Enum=etcd;kubelet;kube-apiserver;kube-proxy;kube-scheduler;kube-controller-manager
+	Service string `json:"service,omitempty"`
 }
 
 // ClusterObservation are the observable fields of a Cluster.
 type ClusterObservation struct {
 	ID string `json:"id,omitempty"`
+	// EtcdSnapshots are the etcd snapshots of the cluster, both recurring and
+	// on-demand.
+	EtcdSnapshots []ClusterEtcdSnapshot `json:"etcdSnapshots,omitempty"`
+	// CertificateRotation is the state of the last certificate rotation.
+	CertificateRotation *CertificateRotationStatus `json:"certificateRotation,omitempty"`
+	// KubernetesVersion is the Kubernetes version configured in Rancher.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// Upgrade is the state of the last Kubernetes upgrade.
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+	// FinalSnapshotID is the Rancher ID of the etcd backup taken before the
+	// cluster is deleted.
+	FinalSnapshotID string `json:"finalSnapshotId,omitempty"`
+	// Nodes are the nodes of the cluster.
+	Nodes []ClusterNode `json:"nodes,omitempty"`
+	// NodePools are the node pools of the cluster.
+	NodePools []ClusterNodePool `json:"nodePools,omitempty"`
+	// RegisteredNodes counts the custom nodes registered with a cluster
+	// without node pools.
+	RegisteredNodes *RegisteredNodes `json:"registeredNodes,omitempty"`
+}
+
+// ClusterNodePool is a node pool of a cluster. Node pools are identified by
+// their hostname prefix.
+type ClusterNodePool struct {
+	// ID is the Rancher ID of the node pool.
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	HostnamePrefix string `json:"hostnamePrefix"`
+	NodeTemplateID string `json:"nodeTemplateId,omitempty"`
+	State          string `json:"state,omitempty"`
+}
+
+// ClusterNode is a node of a cluster.
+type ClusterNode struct {
+	// ID is the Rancher ID of the node.
+	ID       string `json:"id"`
+	Name     string `json:"name,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+	// NodePoolID is the Rancher ID of the node pool of the node, if any.
+	NodePoolID        string   `json:"nodePoolId,omitempty"`
+	Roles             []string `json:"roles,omitempty"`
+	State             string   `json:"state,omitempty"`
+	IPAddress         string   `json:"ipAddress,omitempty"`
+	ExternalIPAddress string   `json:"externalIpAddress,omitempty"`
+	KubeletVersion    string   `json:"kubeletVersion,omitempty"`
+	Unschedulable     bool     `json:"unschedulable,omitempty"`
+}
+
+// RegisteredNodes counts the nodes registered with a custom cluster by role. A
+// node with several roles is counted for each of them.
+type RegisteredNodes struct {
+	Total        int `json:"total"`
+	Etcd         int `json:"etcd"`
+	ControlPlane int `json:"controlPlane"`
+	Worker       int `json:"worker"`
+	// Active is the number of nodes that are active.
+	Active int `json:"active"`
+}
+
+// UpgradeStatus is the state of a Kubernetes upgrade of a cluster.
+type UpgradeStatus struct {
+	FromVersion string `json:"fromVersion,omitempty"`
+	ToVersion   string `json:"toVersion"`
+	// SnapshotID is the Rancher ID of the etcd backup taken before the
+	// upgrade.
+	SnapshotID  string       `json:"snapshotId,omitempty"`
+	StartedAt   *metav1.Time `json:"startedAt,omitempty"`
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Nodes is the progress of the upgrade per node.
+	Nodes []NodeUpgradeStatus `json:"nodes,omitempty"`
+}
+
+// NodeUpgradeStatus is the progress of a Kubernetes upgrade on a node.
+type NodeUpgradeStatus struct {
+	Name           string `json:"name"`
+	State          string `json:"state,omitempty"`
+	KubeletVersion string `json:"kubeletVersion,omitempty"`
+	// Upgraded is true once the kubelet of the node runs the new version.
+	Upgraded bool `json:"upgraded"`
+}
+
+// TypeUpgradeFailed is the condition reporting whether a Kubernetes upgrade
+// has stalled.
+const TypeUpgradeFailed xpv1.ConditionType = "UpgradeFailed"
+
+// Reasons an upgrade has or has not failed.
+const (
+	ReasonUpgrading       xpv1.ConditionReason = "Upgrading"
+	ReasonUpgradeComplete xpv1.ConditionReason = "Completed"
+	ReasonUpgradeTimedOut xpv1.ConditionReason = "TimedOut"
+)
+
+// Upgrading returns a condition that indicates an upgrade is in progress.
+func Upgrading() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgradeFailed,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgrading,
+	}
+}
+
+// UpgradeComplete returns a condition that indicates the upgrade completed.
+func UpgradeComplete() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgradeFailed,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradeComplete,
+	}
+}
+
+// UpgradeTimedOut returns a condition that indicates the upgrade has not
+// completed within its timeout.
+func UpgradeTimedOut(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpgradeFailed,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradeTimedOut,
+		Message:            msg,
+	}
+}
+
+// CertificateRotationStatus is the state of the last certificate rotation of
+// a cluster.
+type CertificateRotationStatus struct {
+	// Generation last rotated.
+	Generation int64 `json:"generation"`
+	// RequestedAt is when the last rotation was requested.
+	RequestedAt *metav1.Time `json:"requestedAt,omitempty"`
+	// LastRotationTime is when the last rotation completed.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+}
+
+// ClusterEtcdSnapshot is an etcd snapshot of a cluster.
+type ClusterEtcdSnapshot struct {
+	// ID is the Rancher ID of the etcd backup, usable as etcdBackupId of an
+	// EtcdSnapshotRestore.
+	ID       string `json:"id"`
+	Filename string `json:"filename,omitempty"`
+	Manual   bool   `json:"manual,omitempty"`
+	State    string `json:"state,omitempty"`
+	Created  string `json:"created,omitempty"`
 }
 
 // A ClusterSpec defines the desired state of a Cluster.
@@ -95,6 +337,7 @@ type ClusterStatus struct {
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+// +kubebuilder:storageversion
 type RKE1Cluster struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -123,3 +366,66 @@ var (
 func init() {
 	SchemeBuilder.Register(&RKE1Cluster{}, &RKE1ClusterList{})
 }
+
+// DeletionProtectionAnnotation protects a cluster from deletion like
+// deletionProtection when set to "true".
+const DeletionProtectionAnnotation = "rke1.rancher.crossplane.io/deletion-protection"
+
+// TypeDeletionBlocked is the condition reporting why a cluster that is being
+// deleted has not been deleted from Rancher yet.
+const TypeDeletionBlocked xpv1.ConditionType = "DeletionBlocked"
+
+// Reasons the deletion of a cluster is blocked.
+const (
+	ReasonDeletionProtected    xpv1.ConditionReason = "DeletionProtected"
+	ReasonWorkloadsRunning     xpv1.ConditionReason = "WorkloadsRunning"
+	ReasonFinalSnapshotPending xpv1.ConditionReason = "FinalSnapshotPending"
+	ReasonDeletionAllowed      xpv1.ConditionReason = "DeletionAllowed"
+)
+
+// DeletionProtected returns a condition that indicates the cluster is
+// protected from deletion.
+func DeletionProtected() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionProtected,
+		Message:            "deletionProtection is enabled",
+	}
+}
+
+// WorkloadsRunning returns a condition that indicates the cluster is not
+// deleted because it still runs workloads.
+func WorkloadsRunning(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWorkloadsRunning,
+		Message:            msg,
+	}
+}
+
+// FinalSnapshotPending returns a condition that indicates the cluster is not
+// deleted until its final etcd snapshot with the supplied ID has completed.
+func FinalSnapshotPending(id string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonFinalSnapshotPending,
+		Message:            fmt.Sprintf("waiting for final etcd snapshot %s to complete", id),
+	}
+}
+
+// DeletionAllowed returns a condition that indicates nothing blocks the
+// deletion of the cluster anymore.
+func DeletionAllowed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionAllowed,
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NetworkPlugins are the network plugins RKE can deploy.
+var NetworkPlugins = []string{"canal", "flannel", "calico", "weave", "aci", "none"}
+
+// SetupWebhookWithManager registers the webhooks of RKE1Cluster.
+func (in *RKE1Cluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(in).Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-rke1-rancher-crossplane-io-v1alpha1-rke1cluster,mutating=true,failurePolicy=fail,groups=rke1.rancher.crossplane.io,resources=rke1clusters,versions=v1alpha1,name=default.rke1clusters.rke1.rancher.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &RKE1Cluster{}
+
+// Default sets the fields of the RKE configuration left empty to the values
+// Rancher would use, so that the spec compares equal to the cluster observed
+// in Rancher.
+func (in *RKE1Cluster) Default() {
+	in.Spec.ForProvider.RKE.RKEClusterSpec.SetDefaults()
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-rke1-rancher-crossplane-io-v1alpha1-rke1cluster,mutating=false,failurePolicy=fail,groups=rke1.rancher.crossplane.io,resources=rke1clusters,versions=v1alpha1,name=rke1clusters.rke1.rancher.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Validator = &RKE1Cluster{}
+
+// ValidateCreate rejects RKE1Clusters Rancher would fail to provision.
+func (in *RKE1Cluster) ValidateCreate() error {
+	return in.toError(in.validate())
+}
+
+// ValidateUpdate validates changed specs and additionally rejects changes of
+// fields that cannot be changed once the cluster is created in Rancher.
+// Clusters being deleted are not validated, so that their finalizers can
+// always be removed.
+func (in *RKE1Cluster) ValidateUpdate(old runtime.Object) error {
+	if in.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	o, ok := old.(*RKE1Cluster)
+	if !ok {
+		return in.toError(in.validate())
+	}
+	// Objects created before defaulting was introduced would otherwise
+	// appear to change their defaulted fields.
+	o = o.DeepCopy()
+	o.Default()
+	var errs field.ErrorList
+	if !equality.Semantic.DeepEqual(in.Spec, o.Spec) {
+		errs = in.validate()
+	}
+	if o.Status.AtProvider.ID != "" {
+		errs = append(errs, in.validateImmutable(o)...)
+	}
+	return in.toError(errs)
+}
+
+// ValidateDelete refuses to delete a cluster that is protected from deletion,
+// unless the deletion policy orphans it in Rancher.
+func (in *RKE1Cluster) ValidateDelete() error {
+	if in.DeletionProtected() && in.GetDeletionPolicy() != xpv1.DeletionOrphan {
+		gr := schema.GroupResource{Group: ClusterGroupVersionKind.Group, Resource: "rke1clusters"}
+		return apierrors.NewForbidden(gr, in.Name, fmt.Errorf("the cluster is protected from deletion; unset deletionProtection and the %s annotation first", DeletionProtectionAnnotation))
+	}
+	return nil
+}
+
+// DeletionProtected reports whether the cluster is protected from deletion by
+// deletionProtection or the deletion-protection annotation.
+func (in *RKE1Cluster) DeletionProtected() bool {
+	return in.Spec.ForProvider.DeletionProtection || in.GetAnnotations()[DeletionProtectionAnnotation] == "true"
+}
+
+func (in *RKE1Cluster) toError(errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(ClusterGroupVersionKind.GroupKind(), in.Name, errs)
+}
+
+func (in *RKE1Cluster) validate() field.ErrorList {
+	p := in.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	errs := validateNodePools(p.NodePools, path.Child("nodePools"))
+
+	rke := p.RKE.RKEClusterSpec
+	rkePath := path.Child("rke", "rancherKubernetesEngineConfig")
+	if plugin := rke.Network.Plugin; plugin != "" && !contains(NetworkPlugins, plugin) {
+		errs = append(errs, field.NotSupported(rkePath.Child("network", "plugin"), plugin, NetworkPlugins))
+	}
+	if r := rke.Services.KubeAPI.ServiceNodePortRange; r != "" {
+		if err := validatePortRange(r); err != nil {
+			errs = append(errs, field.Invalid(rkePath.Child("services", "kubeApi", "serviceNodePortRange"), r, err.Error()))
+		}
+	}
+	for _, cidr := range cidrs(rke, rkePath) {
+		if _, _, err := net.ParseCIDR(cidr.value); cidr.value != "" && err != nil {
+			errs = append(errs, field.Invalid(cidr.path, cidr.value, "must be a CIDR"))
+		}
+	}
+	return errs
+}
+
+// validateNodePools checks that every pool has a role, a node template and a
+// unique hostname prefix, and that the pools form a cluster with an odd number
+// of etcd nodes and at least one control plane node. Clusters without pools are not checked for
+// roles since their nodes are registered by other means.
+func validateNodePools(pools []RKENodePool, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if len(pools) == 0 {
+		return nil
+	}
+	var etcd, controlPlane int64
+	prefixes := map[string]bool{}
+	for i, pool := range pools {
+		p := path.Index(i)
+		switch {
+		case pool.HostnamePrefix == "":
+			errs = append(errs, field.Required(p.Child("hostnamePrefix"), "node pools are identified by their hostname prefix"))
+		case prefixes[pool.HostnamePrefix]:
+			errs = append(errs, field.Duplicate(p.Child("hostnamePrefix"), pool.HostnamePrefix))
+		}
+		prefixes[pool.HostnamePrefix] = true
+		if !pool.ETCD && !pool.ControlPlane && !pool.Worker {
+			errs = append(errs, field.Required(p, "node pool must have at least one of the etcd, controlPlane and worker roles"))
+		}
+		if pool.NodeTemplateID != "" && pool.NodeTemplateIDRef != "" {
+			errs = append(errs, field.Forbidden(p.Child("nodeTemplateIdRef"), "nodeTemplateId and nodeTemplateIdRef are mutually exclusive"))
+		}
+		if pool.ETCD {
+			etcd += pool.Quantity
+		}
+		if pool.ControlPlane {
+			controlPlane += pool.Quantity
+		}
+	}
+	if etcd == 0 {
+		errs = append(errs, field.Required(path, "at least one node pool must have the etcd role"))
+	} else if etcd%2 == 0 {
+		errs = append(errs, field.Invalid(path, etcd, "number of etcd nodes must be odd to keep quorum"))
+	}
+	if controlPlane == 0 {
+		errs = append(errs, field.Required(path, "at least one node pool must have the controlPlane role"))
+	}
+	return errs
+}
+
+// validatePortRange checks a port range of the form 30000-32767.
+func validatePortRange(r string) error {
+	from, to, ok := strings.Cut(r, "-")
+	if !ok {
+		return fmt.Errorf("must be a port range of the form <first>-<last>")
+	}
+	first, err := strconv.Atoi(from)
+	if err != nil || first < 1 || first > 65535 {
+		return fmt.Errorf("first port %q must be a number between 1 and 65535", from)
+	}
+	last, err := strconv.Atoi(to)
+	if err != nil || last < 1 || last > 65535 {
+		return fmt.Errorf("last port %q must be a number between 1 and 65535", to)
+	}
+	if first >= last {
+		return fmt.Errorf("first port must be lower than last port")
+	}
+	return nil
+}
+
+type pathValue struct {
+	path  *field.Path
+	value string
+}
+
+// cidrs returns the cluster CIDRs of a RKE configuration.
+func cidrs(rke RancherKubernetesEngineConfig, path *field.Path) []pathValue {
+	return []pathValue{
+		{path.Child("services", "kubeController", "clusterCidr"), rke.Services.KubeController.ClusterCIDR},
+		{path.Child("services", "kubeController", "serviceClusterIpRange"), rke.Services.KubeController.ServiceClusterIPRange},
+		{path.Child("services", "kubeApi", "serviceClusterIpRange"), rke.Services.KubeAPI.ServiceClusterIPRange},
+	}
+}
+
+// validateImmutable rejects changes of the network plugin and the cluster
+// CIDRs, which RKE cannot change on a provisioned cluster.
+func (in *RKE1Cluster) validateImmutable(old *RKE1Cluster) field.ErrorList {
+	var errs field.ErrorList
+	path := field.NewPath("spec", "forProvider", "rke", "rancherKubernetesEngineConfig")
+	newRKE, oldRKE := in.Spec.ForProvider.RKE.RKEClusterSpec, old.Spec.ForProvider.RKE.RKEClusterSpec
+	newVals := append([]pathValue{{path.Child("network", "plugin"), newRKE.Network.Plugin}}, cidrs(newRKE, path)...)
+	oldVals := append([]pathValue{{path.Child("network", "plugin"), oldRKE.Network.Plugin}}, cidrs(oldRKE, path)...)
+	for i, v := range newVals {
+		if v.value != oldVals[i].value {
+			errs = append(errs, immutable(v.path, oldVals[i].value, v.value))
+		}
+	}
+	return errs
+}
+
+func immutable(path *field.Path, oldVal, newVal string) *field.Error {
+	return field.Forbidden(path, fmt.Sprintf("field is immutable, cannot change %q to %q", oldVal, newVal))
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// equateErrors considers errors equal when their messages are. The test
+// package of crossplane-runtime does not build against the controller-runtime
+// version of this module.
+func equateErrors() cmp.Option {
+	return cmp.Comparer(func(a, b error) bool {
+		if a == nil || b == nil {
+			return a == nil && b == nil
+		}
+		return a.Error() == b.Error()
+	})
+}
+
+type clusterModifier func(*RKE1Cluster)
+
+func withNodePools(pools ...RKENodePool) clusterModifier {
+	return func(cr *RKE1Cluster) { cr.Spec.ForProvider.NodePools = pools }
+}
+
+func withNetworkPlugin(plugin string) clusterModifier {
+	return func(cr *RKE1Cluster) { cr.Spec.ForProvider.RKE.RKEClusterSpec.Network.Plugin = plugin }
+}
+
+func withClusterCIDR(cidr string) clusterModifier {
+	return func(cr *RKE1Cluster) {
+		cr.Spec.ForProvider.RKE.RKEClusterSpec.Services.KubeController.ClusterCIDR = cidr
+	}
+}
+
+func withNodePortRange(r string) clusterModifier {
+	return func(cr *RKE1Cluster) {
+		cr.Spec.ForProvider.RKE.RKEClusterSpec.Services.KubeAPI.ServiceNodePortRange = r
+	}
+}
+
+func withID(id string) clusterModifier {
+	return func(cr *RKE1Cluster) { cr.Status.AtProvider.ID = id }
+}
+
+func withDeletionTimestamp() clusterModifier {
+	return func(cr *RKE1Cluster) { now := metav1.Now(); cr.SetDeletionTimestamp(&now) }
+}
+
+// cluster returns a defaulted cluster, as admitted by the defaulting webhook.
+func cluster(m ...clusterModifier) *RKE1Cluster {
+	cr := &RKE1Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cool-cluster"}}
+	for _, f := range m {
+		f(cr)
+	}
+	cr.Default()
+	return cr
+}
+
+func pool(prefix string, quantity int64) RKENodePool {
+	return RKENodePool{HostnamePrefix: prefix, Quantity: quantity, ETCD: true, ControlPlane: true, Worker: true}
+}
+
+func invalid(errs ...*field.Error) error {
+	return cluster().toError(errs)
+}
+
+func TestClusterValidateCreate(t *testing.T) {
+	pools := field.NewPath("spec", "forProvider", "nodePools")
+	rke := field.NewPath("spec", "forProvider", "rke", "rancherKubernetesEngineConfig")
+
+	cases := map[string]struct {
+		reason string
+		cr     *RKE1Cluster
+		want   error
+	}{
+		"Valid": {
+			reason: "A cluster with an odd number of etcd nodes and a control plane should be admitted.",
+			cr:     cluster(withNodePools(pool("a", 3))),
+		},
+		"NoNodePools": {
+			reason: "Clusters without node pools register their nodes by other means and should be admitted.",
+			cr:     cluster(),
+		},
+		"NoHostnamePrefix": {
+			reason: "Node pools are identified by their hostname prefix, which should be required.",
+			cr:     cluster(withNodePools(pool("", 1))),
+			want:   invalid(field.Required(pools.Index(0).Child("hostnamePrefix"), "node pools are identified by their hostname prefix")),
+		},
+		"DuplicateHostnamePrefix": {
+			reason: "Hostname prefixes should be unique.",
+			cr:     cluster(withNodePools(pool("a", 1), pool("a", 2))),
+			want:   invalid(field.Duplicate(pools.Index(1).Child("hostnamePrefix"), "a")),
+		},
+		"EvenEtcdNodes": {
+			reason: "An even number of etcd nodes cannot keep quorum and should be rejected.",
+			cr:     cluster(withNodePools(pool("a", 1), pool("b", 1))),
+			want:   invalid(field.Invalid(pools, int64(2), "number of etcd nodes must be odd to keep quorum")),
+		},
+		"UnsupportedNetworkPlugin": {
+			reason: "Network plugins RKE cannot deploy should be rejected.",
+			cr:     cluster(withNetworkPlugin("cilium")),
+			want:   invalid(field.NotSupported(rke.Child("network", "plugin"), "cilium", NetworkPlugins)),
+		},
+		"InvalidNodePortRange": {
+			reason: "Node port ranges whose first port is not lower than their last should be rejected.",
+			cr:     cluster(withNodePortRange("32767-30000")),
+			want:   invalid(field.Invalid(rke.Child("services", "kubeApi", "serviceNodePortRange"), "32767-30000", "first port must be lower than last port")),
+		},
+		"InvalidCIDR": {
+			reason: "Cluster CIDRs that cannot be parsed should be rejected.",
+			cr:     cluster(withClusterCIDR("10.42.0.0")),
+			want:   invalid(field.Invalid(rke.Child("services", "kubeController", "clusterCidr"), "10.42.0.0", "must be a CIDR")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cr.ValidateCreate()
+			if diff := cmp.Diff(tc.want, err, equateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateCreate(): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestClusterValidateUpdate(t *testing.T) {
+	rke := field.NewPath("spec", "forProvider", "rke", "rancherKubernetesEngineConfig")
+
+	cases := map[string]struct {
+		reason string
+		old    *RKE1Cluster
+		cr     *RKE1Cluster
+		want   error
+	}{
+		"Deleting": {
+			reason: "Clusters being deleted should not be validated, so that their finalizers can be removed.",
+			old:    cluster(withID("c-123"), withNetworkPlugin("cilium")),
+			cr:     cluster(withID("c-123"), withNetworkPlugin("cilium"), withDeletionTimestamp()),
+		},
+		"SpecUnchanged": {
+			reason: "Clusters admitted before a validation was introduced should remain updatable while their spec is unchanged.",
+			old:    cluster(withNodePools(pool("a", 2))),
+			cr:     cluster(withNodePools(pool("a", 2)), withID("c-123")),
+		},
+		"SpecChanged": {
+			reason: "Changed specs should be validated.",
+			old:    cluster(withNodePools(pool("a", 1))),
+			cr:     cluster(withNodePools(pool("a", 1)), withNetworkPlugin("cilium")),
+			want:   invalid(field.NotSupported(rke.Child("network", "plugin"), "cilium", NetworkPlugins)),
+		},
+		"ImmutableFieldChanged": {
+			reason: "The network plugin of a cluster created in Rancher should not be changed.",
+			old:    cluster(withID("c-123"), withNetworkPlugin("canal")),
+			cr:     cluster(withID("c-123"), withNetworkPlugin("flannel")),
+			want:   invalid(immutable(rke.Child("network", "plugin"), "canal", "flannel")),
+		},
+		"NotCreated": {
+			reason: "The network plugin of a cluster not created in Rancher yet may be changed.",
+			old:    cluster(withNetworkPlugin("canal")),
+			cr:     cluster(withNetworkPlugin("flannel")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cr.ValidateUpdate(tc.old)
+			if diff := cmp.Diff(tc.want, err, equateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateUpdate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ClusterTemplateMember grants a user or group access to a cluster
+// template.
+type ClusterTemplateMember struct {
+	// AccessType is owner, member or read-only.
+	// +kubebuilder:validation:Enum=owner;member;read-only
+	AccessType       string `json:"accessType"`
+	UserPrincipalID  string `json:"userPrincipalId,omitempty"`
+	GroupPrincipalID string `json:"groupPrincipalId,omitempty"`
+}
+
+// ClusterTemplateParameters are the configurable fields of a ClusterTemplate.
+type ClusterTemplateParameters struct {
+	Description string `json:"description,omitempty"`
+	// Members are the users and groups allowed to use or manage the
+	// template.
+	Members []ClusterTemplateMember `json:"members,omitempty"`
+	// DefaultRevisionID is the Rancher ID of the revision offered by default.
+	DefaultRevisionID string `json:"defaultRevisionId,omitempty"`
+	// DefaultRevisionIDRef is the name of a ClusterTemplateRevision. It is
+	// resolved to DefaultRevisionID.
+	DefaultRevisionIDRef string `json:"defaultRevisionIdRef,omitempty"`
+}
+
+// ClusterTemplateObservation are the observable fields of a ClusterTemplate.
+type ClusterTemplateObservation struct {
+	ID                string `json:"id,omitempty"`
+	DefaultRevisionID string `json:"defaultRevisionId,omitempty"`
+}
+
+// A ClusterTemplateSpec defines the desired state of a ClusterTemplate.
+type ClusterTemplateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterTemplateParameters `json:"forProvider"`
+}
+
+// A ClusterTemplateStatus represents the observed state of a ClusterTemplate.
+type ClusterTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterTemplate is a Rancher RKE1 cluster template. Its revisions hold
+// the cluster configuration.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type ClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterTemplateSpec   `json:"spec"`
+	Status ClusterTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterTemplateList contains a list of ClusterTemplate
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTemplate `json:"items"`
+}
+
+// ClusterTemplate type metadata.
+var (
+	ClusterTemplateKind             = reflect.TypeOf(ClusterTemplate{}).Name()
+	ClusterTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterTemplateKind}.String()
+	ClusterTemplateKindAPIVersion   = ClusterTemplateKind + "." + SchemeGroupVersion.String()
+	ClusterTemplateGroupVersionKind = SchemeGroupVersion.WithKind(ClusterTemplateKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ClusterTemplate{}, &ClusterTemplateList{})
+}
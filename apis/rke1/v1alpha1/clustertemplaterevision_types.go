@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ClusterTemplateQuestion lets users override a field of the cluster
+// configuration of a revision.
+type ClusterTemplateQuestion struct {
+	// Variable is the path of the overridden field, e.g.
+	// rancherKubernetesEngineConfig.kubernetesVersion.
+	Variable    string `json:"variable"`
+	Label       string `json:"label,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Type of the answer, e.g. string, boolean, int, password or enum.
+	Type     string `json:"type,omitempty"`
+	Required bool   `json:"required,omitempty"`
+	// Default answer.
+	Default string `json:"default,omitempty"`
+	Group   string `json:"group,omitempty"`
+	// Options are the allowed answers of enum questions.
+	Options []string `json:"options,omitempty"`
+}
+
+// ClusterTemplateRevisionParameters are the configurable fields of a ClusterTemplateRevision.
+type ClusterTemplateRevisionParameters struct {
+	// ClusterTemplateID is the Rancher ID of the template the revision
+	// belongs to.
+	ClusterTemplateID string `json:"clusterTemplateId,omitempty"`
+	// ClusterTemplateIDRef is the name of a ClusterTemplate. It is resolved to
+	// ClusterTemplateID.
+	ClusterTemplateIDRef string `json:"clusterTemplateIdRef,omitempty"`
+	// Enabled controls whether new clusters may use the revision. Defaults
+	// to true.
+	Enabled *bool `json:"enabled,omitempty"`
+	// ClusterConfig is the configuration of clusters created from the
+	// revision.
+	ClusterConfig RKEClusterConfigSpec `json:"clusterConfig"`
+	// Questions are the fields of ClusterConfig users may override with
+	// answers when creating a cluster.
+	Questions []ClusterTemplateQuestion `json:"questions,omitempty"`
+}
+
+// ClusterTemplateRevisionObservation are the observable fields of a ClusterTemplateRevision.
+type ClusterTemplateRevisionObservation struct {
+	ID                string `json:"id,omitempty"`
+	ClusterTemplateID string `json:"clusterTemplateId,omitempty"`
+}
+
+// A ClusterTemplateRevisionSpec defines the desired state of a ClusterTemplateRevision.
+type ClusterTemplateRevisionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterTemplateRevisionParameters `json:"forProvider"`
+}
+
+// A ClusterTemplateRevisionStatus represents the observed state of a ClusterTemplateRevision.
+type ClusterTemplateRevisionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterTemplateRevisionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterTemplateRevision is a revision of a Rancher RKE1 cluster template.
+// The cluster configuration of a revision cannot be changed once created.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="TEMPLATE",type="string",JSONPath=".status.atProvider.clusterTemplateId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type ClusterTemplateRevision struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterTemplateRevisionSpec   `json:"spec"`
+	Status ClusterTemplateRevisionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterTemplateRevisionList contains a list of ClusterTemplateRevision
+type ClusterTemplateRevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTemplateRevision `json:"items"`
+}
+
+// ClusterTemplateRevision type metadata.
+var (
+	ClusterTemplateRevisionKind             = reflect.TypeOf(ClusterTemplateRevision{}).Name()
+	ClusterTemplateRevisionGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterTemplateRevisionKind}.String()
+	ClusterTemplateRevisionKindAPIVersion   = ClusterTemplateRevisionKind + "." + SchemeGroupVersion.String()
+	ClusterTemplateRevisionGroupVersionKind = SchemeGroupVersion.WithKind(ClusterTemplateRevisionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ClusterTemplateRevision{}, &ClusterTemplateRevisionList{})
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1beta1"
+)
+
+// ConversionAnnotation records the fields of the spec of the version an object
+// was converted from that the version it was converted to cannot represent,
+// such as explicitly false bools of v1beta1. Converting the object back
+// restores the recorded fields unless they have changed in the meantime. The
+// annotation records only the fields lost in the conversion, never secrets:
+// the inline secrets of v1alpha1, which v1beta1 only accepts as secret
+// references, are lost when a v1alpha1 object is updated through v1beta1.
+const ConversionAnnotation = "rke1.rancher.crossplane.io/conversion-spec"
+
+// secretFields are the JSON names of the fields holding secrets, which are
+// never recorded in the conversion annotation.
+var secretFields = map[string]bool{
+	"aadClientCertPassword": true,
+	"aadClientSecret":       true,
+	"password":              true,
+	"secretKey":             true,
+	"sessionToken":          true,
+	"sshKey":                true,
+	"sshKeyContents":        true,
+}
+
+const errConvert = "cannot convert between API versions"
+
+var _ conversion.Convertible = &RKE1Cluster{}
+
+// ConvertTo converts the RKE1Cluster to the v1beta1 hub.
+func (in *RKE1Cluster) ConvertTo(hub conversion.Hub) error {
+	dst := hub.(*v1beta1.RKE1Cluster)
+	dst.ObjectMeta = in.ObjectMeta
+	if err := convertSpec(&in.ObjectMeta, &dst.ObjectMeta, in.Spec, &dst.Spec,
+		func() interface{} { return &ClusterSpec{} }, func() interface{} { return &v1beta1.ClusterSpec{} }); err != nil {
+		return err
+	}
+	return convert(in.Status, &dst.Status)
+}
+
+// ConvertFrom converts the v1beta1 hub to a RKE1Cluster.
+func (in *RKE1Cluster) ConvertFrom(hub conversion.Hub) error {
+	src := hub.(*v1beta1.RKE1Cluster)
+	in.ObjectMeta = src.ObjectMeta
+	if err := convertSpec(&src.ObjectMeta, &in.ObjectMeta, src.Spec, &in.Spec,
+		func() interface{} { return &v1beta1.ClusterSpec{} }, func() interface{} { return &ClusterSpec{} }); err != nil {
+		return err
+	}
+	return convert(src.Status, &in.Status)
+}
+
+var _ conversion.Convertible = &RKE1NodeTemplate{}
+
+// ConvertTo converts the RKE1NodeTemplate to the v1beta1 hub.
+func (in *RKE1NodeTemplate) ConvertTo(hub conversion.Hub) error {
+	dst := hub.(*v1beta1.RKE1NodeTemplate)
+	dst.ObjectMeta = in.ObjectMeta
+	if err := convertSpec(&in.ObjectMeta, &dst.ObjectMeta, in.Spec, &dst.Spec,
+		func() interface{} { return &RKE1NodeTemplateSpec{} }, func() interface{} { return &v1beta1.RKE1NodeTemplateSpec{} }); err != nil {
+		return err
+	}
+	return convert(in.Status, &dst.Status)
+}
+
+// ConvertFrom converts the v1beta1 hub to a RKE1NodeTemplate.
+func (in *RKE1NodeTemplate) ConvertFrom(hub conversion.Hub) error {
+	src := hub.(*v1beta1.RKE1NodeTemplate)
+	in.ObjectMeta = src.ObjectMeta
+	if err := convertSpec(&src.ObjectMeta, &in.ObjectMeta, src.Spec, &in.Spec,
+		func() interface{} { return &v1beta1.RKE1NodeTemplateSpec{} }, func() interface{} { return &RKE1NodeTemplateSpec{} }); err != nil {
+		return err
+	}
+	return convert(src.Status, &in.Status)
+}
+
+// convertSpec converts the spec of an object to the spec of another version.
+// Both versions share the JSON representation of all fields they have in
+// common, so the spec is converted through JSON. newSrc and newDst return
+// empty specs of the source and destination versions.
+func convertSpec(srcMeta, dstMeta *metav1.ObjectMeta, srcSpec, dstSpec interface{}, newSrc, newDst func() interface{}) error {
+	srcJSON, err := json.Marshal(srcSpec)
+	if err != nil {
+		return errors.Wrap(err, errConvert)
+	}
+	dstMeta.Annotations = withoutConversionAnnotation(srcMeta.Annotations)
+	if err := json.Unmarshal(srcJSON, dstSpec); err != nil {
+		return errors.Wrap(err, errConvert)
+	}
+
+	// Restore the fields recorded when the object was converted from the
+	// destination version, provided the spec still converts to the source
+	// spec with them.
+	if recorded, ok := srcMeta.Annotations[ConversionAnnotation]; ok {
+		restored, err := restoreFields(dstSpec, recorded, newDst, newSrc, srcJSON)
+		if err != nil {
+			return err
+		}
+		if restored != nil {
+			return errors.Wrap(json.Unmarshal(restored, dstSpec), errConvert)
+		}
+	}
+
+	roundTrip := newSrc()
+	if err := convert(dstSpec, roundTrip); err != nil {
+		return err
+	}
+	lost, err := lostFields(srcJSON, roundTrip)
+	if err != nil {
+		return err
+	}
+	if lost != nil {
+		if dstMeta.Annotations == nil {
+			dstMeta.Annotations = map[string]string{}
+		}
+		dstMeta.Annotations[ConversionAnnotation] = string(lost)
+	}
+	return nil
+}
+
+// lostFields returns the fields of the source spec that differ from the spec
+// converted back from the destination version, without secrets, as a JSON
+// merge patch. It returns nil if no fields but secrets were lost.
+func lostFields(srcJSON []byte, roundTrip interface{}) ([]byte, error) {
+	var src, rt map[string]interface{}
+	if err := json.Unmarshal(srcJSON, &src); err != nil {
+		return nil, errors.Wrap(err, errConvert)
+	}
+	if err := convert(roundTrip, &rt); err != nil {
+		return nil, err
+	}
+	patch := mergePatch(withoutSecrets(rt).(map[string]interface{}), withoutSecrets(src).(map[string]interface{}))
+	if len(patch) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(patch)
+	return b, errors.Wrap(err, errConvert)
+}
+
+// restoreFields applies the recorded fields to the converted spec. It returns
+// the JSON of the spec with the fields restored, or nil if the spec with the
+// restored fields does not convert to the source spec, because the fields
+// have been changed in the source version.
+func restoreFields(dstSpec interface{}, recorded string, newDst, newSrc func() interface{}, srcJSON []byte) ([]byte, error) {
+	var patch map[string]interface{}
+	if err := json.Unmarshal([]byte(recorded), &patch); err != nil {
+		return nil, nil // nolint:nilerr // An unreadable annotation is ignored.
+	}
+	var dst map[string]interface{}
+	if err := convert(dstSpec, &dst); err != nil {
+		return nil, err
+	}
+	restored, err := json.Marshal(applyMergePatch(dst, patch))
+	if err != nil {
+		return nil, errors.Wrap(err, errConvert)
+	}
+	candidate := newDst()
+	if err := json.Unmarshal(restored, candidate); err != nil {
+		return nil, nil // nolint:nilerr // Fields that no longer fit the spec are not restored.
+	}
+	roundTrip := newSrc()
+	if err := convert(candidate, roundTrip); err != nil {
+		return nil, err
+	}
+	if !equalJSON(roundTrip, srcJSON) {
+		return nil, nil
+	}
+	return restored, nil
+}
+
+// mergePatch returns the JSON merge patch that turns from into to. Arrays are
+// replaced as a whole.
+func mergePatch(from, to map[string]interface{}) map[string]interface{} {
+	patch := map[string]interface{}{}
+	for k, v := range to {
+		prev, ok := from[k]
+		if ok && reflect.DeepEqual(prev, v) {
+			continue
+		}
+		vm, vok := v.(map[string]interface{})
+		pm, pok := prev.(map[string]interface{})
+		if vok && pok {
+			patch[k] = mergePatch(pm, vm)
+			continue
+		}
+		patch[k] = v
+	}
+	for k := range from {
+		if _, ok := to[k]; !ok {
+			patch[k] = nil
+		}
+	}
+	return patch
+}
+
+// applyMergePatch applies a JSON merge patch to doc, which it modifies.
+func applyMergePatch(doc, patch map[string]interface{}) map[string]interface{} {
+	if doc == nil {
+		doc = map[string]interface{}{}
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(doc, k)
+			continue
+		}
+		if vm, ok := v.(map[string]interface{}); ok {
+			dm, _ := doc[k].(map[string]interface{})
+			doc[k] = applyMergePatch(dm, vm)
+			continue
+		}
+		doc[k] = v
+	}
+	return doc
+}
+
+// withoutSecrets returns a copy of a JSON value without secret fields.
+func withoutSecrets(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			if !secretFields[k] {
+				out[k] = withoutSecrets(e)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = withoutSecrets(e)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// convert converts between versions of a type through JSON.
+func convert(from, to interface{}) error {
+	b, err := json.Marshal(from)
+	if err != nil {
+		return errors.Wrap(err, errConvert)
+	}
+	return errors.Wrap(json.Unmarshal(b, to), errConvert)
+}
+
+func equalJSON(v interface{}, b []byte) bool {
+	vb, err := json.Marshal(v)
+	return err == nil && bytes.Equal(vb, b)
+}
+
+// withoutConversionAnnotation returns a copy of the annotations without the
+// conversion annotation, leaving the source object untouched.
+func withoutConversionAnnotation(in map[string]string) map[string]string {
+	if len(in) == 0 {
+		return in
+	}
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		if k != ConversionAnnotation {
+			out[k] = v
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
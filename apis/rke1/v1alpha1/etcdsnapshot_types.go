@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EtcdSnapshotParameters are the configurable fields of an EtcdSnapshot.
+type EtcdSnapshotParameters struct {
+	// ClusterID is the Rancher ID of the cluster.
+	ClusterID string `json:"clusterId,omitempty"`
+	// ClusterIDRef is the name of the cluster, e.g. the name of a RKE1Cluster.
+	// It is resolved to ClusterID.
+	ClusterIDRef string `json:"clusterIdRef,omitempty"`
+}
+
+// EtcdSnapshotObservation are the observable fields of an EtcdSnapshot.
+type EtcdSnapshotObservation struct {
+	// ID is the Rancher ID of the etcd backup.
+	ID        string `json:"id,omitempty"`
+	ClusterID string `json:"clusterId,omitempty"`
+	// Filename of the snapshot on the etcd nodes and in S3.
+	Filename string `json:"filename,omitempty"`
+	State    string `json:"state,omitempty"`
+	Created  string `json:"created,omitempty"`
+}
+
+// An EtcdSnapshotSpec defines the desired state of an EtcdSnapshot.
+type EtcdSnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EtcdSnapshotParameters `json:"forProvider"`
+}
+
+// An EtcdSnapshotStatus represents the observed state of an EtcdSnapshot.
+type EtcdSnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EtcdSnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EtcdSnapshot is an on-demand etcd snapshot of a RKE1 cluster. Deleting
+// the EtcdSnapshot deletes the snapshot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FILENAME",type="string",JSONPath=".status.atProvider.filename"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type EtcdSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EtcdSnapshotSpec   `json:"spec"`
+	Status EtcdSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EtcdSnapshotList contains a list of EtcdSnapshot
+type EtcdSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EtcdSnapshot `json:"items"`
+}
+
+// EtcdSnapshot type metadata.
+var (
+	EtcdSnapshotKind             = reflect.TypeOf(EtcdSnapshot{}).Name()
+	EtcdSnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: EtcdSnapshotKind}.String()
+	EtcdSnapshotKindAPIVersion   = EtcdSnapshotKind + "." + SchemeGroupVersion.String()
+	EtcdSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(EtcdSnapshotKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EtcdSnapshot{}, &EtcdSnapshotList{})
+}
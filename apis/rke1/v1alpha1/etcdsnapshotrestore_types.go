@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EtcdSnapshotRestoreParameters are the configurable fields of an EtcdSnapshotRestore.
+type EtcdSnapshotRestoreParameters struct {
+	// ClusterID is the Rancher ID of the cluster.
+	ClusterID string `json:"clusterId,omitempty"`
+	// ClusterIDRef is the name of the cluster, e.g. the name of a RKE1Cluster.
+	// It is resolved to ClusterID.
+	ClusterIDRef string `json:"clusterIdRef,omitempty"`
+
+	// EtcdBackupID is the Rancher ID of the etcd backup to restore, e.g.
+	// c-xxxxx:b-xxxxx.
+	EtcdBackupID string `json:"etcdBackupId,omitempty"`
+	// EtcdSnapshotRef is the name of an EtcdSnapshot. It is resolved to
+	// EtcdBackupID.
+	EtcdSnapshotRef string `json:"etcdSnapshotRef,omitempty"`
+	// RestoreRKEConfig selects which part of the cluster configuration saved
+	// with the snapshot is restored along with etcd. Empty restores etcd only,
+	// kubernetesVersion also restores the Kubernetes version and all restores
+	// the whole cluster configuration.
+	// +kubebuilder:validation:Enum="";kubernetesVersion;all
+	RestoreRKEConfig string `json:"restoreRkeConfig,omitempty"`
+}
+
+// TypeRestored is the condition reporting the progress of a restore.
+const TypeRestored xpv1.ConditionType = "Restored"
+
+// Reasons a restore is or is not complete.
+const (
+	ReasonRestoring       xpv1.ConditionReason = "Restoring"
+	ReasonRestoreComplete xpv1.ConditionReason = "Completed"
+	ReasonRestoreFailed   xpv1.ConditionReason = "Failed"
+)
+
+// Restoring returns a condition that indicates a restore is in progress.
+func Restoring() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRestored,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRestoring,
+	}
+}
+
+// RestoreComplete returns a condition that indicates the restore completed.
+func RestoreComplete() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRestored,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRestoreComplete,
+	}
+}
+
+// RestoreFailed returns a condition that indicates the restore failed.
+func RestoreFailed(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRestored,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRestoreFailed,
+		Message:            msg,
+	}
+}
+
+// EtcdSnapshotRestoreObservation are the observable fields of an EtcdSnapshotRestore.
+type EtcdSnapshotRestoreObservation struct {
+	ClusterID string `json:"clusterId,omitempty"`
+	// EtcdBackupID is the etcd backup last restored.
+	EtcdBackupID     string `json:"etcdBackupId,omitempty"`
+	RestoreRKEConfig string `json:"restoreRkeConfig,omitempty"`
+	// StartedAt is when the last restore was requested.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// ClusterState is the state of the cluster being restored.
+	ClusterState string `json:"clusterState,omitempty"`
+}
+
+// An EtcdSnapshotRestoreSpec defines the desired state of an EtcdSnapshotRestore.
+type EtcdSnapshotRestoreSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EtcdSnapshotRestoreParameters `json:"forProvider"`
+}
+
+// An EtcdSnapshotRestoreStatus represents the observed state of an EtcdSnapshotRestore.
+type EtcdSnapshotRestoreStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EtcdSnapshotRestoreObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EtcdSnapshotRestore restores a RKE1 cluster from an etcd snapshot. The
+// restore runs when the EtcdSnapshotRestore is created and again whenever the
+// snapshot or restore mode changes. Its progress is reported by the Restored
+// condition. Deleting the EtcdSnapshotRestore does not undo the restore.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RESTORED",type="string",JSONPath=".status.conditions[?(@.type=='Restored')].status"
+// +kubebuilder:printcolumn:name="BACKUP",type="string",JSONPath=".status.atProvider.etcdBackupId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type EtcdSnapshotRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EtcdSnapshotRestoreSpec   `json:"spec"`
+	Status EtcdSnapshotRestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EtcdSnapshotRestoreList contains a list of EtcdSnapshotRestore
+type EtcdSnapshotRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EtcdSnapshotRestore `json:"items"`
+}
+
+// EtcdSnapshotRestore type metadata.
+var (
+	EtcdSnapshotRestoreKind             = reflect.TypeOf(EtcdSnapshotRestore{}).Name()
+	EtcdSnapshotRestoreGroupKind        = schema.GroupKind{Group: Group, Kind: EtcdSnapshotRestoreKind}.String()
+	EtcdSnapshotRestoreKindAPIVersion   = EtcdSnapshotRestoreKind + "." + SchemeGroupVersion.String()
+	EtcdSnapshotRestoreGroupVersionKind = SchemeGroupVersion.WithKind(EtcdSnapshotRestoreKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EtcdSnapshotRestore{}, &EtcdSnapshotRestoreList{})
+}
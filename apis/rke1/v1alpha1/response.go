@@ -5,7 +5,166 @@ type ClusterResponse struct {
 }
 
 type Data struct {
-	ID    string `json:"id"`
-	State string `json:"state"`
-	Name  string `json:"name"`
+	ID                   string `json:"id"`
+	State                string `json:"state"`
+	Name                 string `json:"name"`
+	Transitioning        string `json:"transitioning,omitempty"`
+	TransitioningMessage string `json:"transitioningMessage,omitempty"`
+
+	RKEConfig *ClusterRKEConfigData `json:"rancherKubernetesEngineConfig,omitempty"`
+}
+
+// ClusterRKEConfigData is the part of the RKE configuration of a cluster
+// observed by the provider.
+type ClusterRKEConfigData struct {
+	Version string `json:"kubernetesVersion,omitempty"`
+}
+
+type ClusterTemplateResponse struct {
+	Data []ClusterTemplateData `json:"data"`
+}
+
+// ClusterTemplateData is a Rancher cluster template.
+type ClusterTemplateData struct {
+	ID                string                  `json:"id,omitempty"`
+	Name              string                  `json:"name"`
+	Description       string                  `json:"description,omitempty"`
+	Members           []ClusterTemplateMember `json:"members,omitempty"`
+	DefaultRevisionID string                  `json:"defaultRevisionId,omitempty"`
+}
+
+type ClusterTemplateRevisionResponse struct {
+	Data []ClusterTemplateRevisionData `json:"data"`
+}
+
+// ClusterTemplateRevisionData is a Rancher cluster template revision.
+type ClusterTemplateRevisionData struct {
+	ID                string                    `json:"id,omitempty"`
+	Name              string                    `json:"name"`
+	ClusterTemplateID string                    `json:"clusterTemplateId"`
+	Enabled           *bool                     `json:"enabled,omitempty"`
+	ClusterConfig     *RKEClusterConfigSpec     `json:"clusterConfig,omitempty"`
+	Questions         []ClusterTemplateQuestion `json:"questions,omitempty"`
+}
+
+// ClusterFromTemplateRequest creates a cluster from a cluster template
+// revision.
+type ClusterFromTemplateRequest struct {
+	Name                      string            `json:"name"`
+	ClusterTemplateRevisionID string            `json:"clusterTemplateRevisionId"`
+	Answers                   *ClusterAnswers   `json:"answers,omitempty"`
+	Labels                    map[string]string `json:"labels,omitempty"`
+}
+
+// ClusterAnswers are the answers to the questions of a cluster template
+// revision.
+type ClusterAnswers struct {
+	Values map[string]string `json:"values,omitempty"`
+}
+
+type EtcdBackupResponse struct {
+	Data []EtcdBackupData `json:"data"`
+}
+
+// EtcdBackupData is an etcd snapshot of a Rancher cluster.
+type EtcdBackupData struct {
+	ID                   string            `json:"id,omitempty"`
+	Name                 string            `json:"name,omitempty"`
+	ClusterID            string            `json:"clusterId,omitempty"`
+	Filename             string            `json:"filename,omitempty"`
+	Manual               bool              `json:"manual,omitempty"`
+	State                string            `json:"state,omitempty"`
+	Created              string            `json:"created,omitempty"`
+	TransitioningMessage string            `json:"transitioningMessage,omitempty"`
+	Status               *EtcdBackupStatus `json:"status,omitempty"`
+}
+
+type EtcdBackupStatus struct {
+	Conditions []RancherCondition `json:"conditions,omitempty"`
+}
+
+// RancherCondition is a condition of a Rancher object.
+type RancherCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// RestoreFromEtcdBackupInput is the input of the restoreFromEtcdBackup action
+// of a cluster.
+type RestoreFromEtcdBackupInput struct {
+	EtcdBackupID     string `json:"etcdBackupId"`
+	RestoreRKEConfig string `json:"restoreRkeConfig,omitempty"`
+}
+
+// RotateCertificateInput is the input of the rotateCertificates action of a
+// cluster.
+type RotateCertificateInput struct {
+	CACertificates bool   `json:"caCertificates"`
+	Services       string `json:"services,omitempty"`
+}
+
+type NodeResponse struct {
+	Data []NodeData `json:"data"`
+}
+
+// NodeData is a node of a Rancher cluster.
+type NodeData struct {
+	ID                   string    `json:"id,omitempty"`
+	Name                 string    `json:"name,omitempty"`
+	NodeName             string    `json:"nodeName,omitempty"`
+	Hostname             string    `json:"hostname,omitempty"`
+	ClusterID            string    `json:"clusterId,omitempty"`
+	NodePoolID           string    `json:"nodePoolId,omitempty"`
+	State                string    `json:"state,omitempty"`
+	Transitioning        string    `json:"transitioning,omitempty"`
+	TransitioningMessage string    `json:"transitioningMessage,omitempty"`
+	ControlPlane         bool      `json:"controlPlane,omitempty"`
+	Etcd                 bool      `json:"etcd,omitempty"`
+	Worker               bool      `json:"worker,omitempty"`
+	IPAddress            string    `json:"ipAddress,omitempty"`
+	ExternalIPAddress    string    `json:"externalIpAddress,omitempty"`
+	Unschedulable        bool      `json:"unschedulable,omitempty"`
+	Info                 *NodeInfo `json:"info,omitempty"`
+}
+
+type NodeInfo struct {
+	Kubernetes *NodeKubernetesInfo `json:"kubernetes,omitempty"`
+}
+
+type NodeKubernetesInfo struct {
+	KubeletVersion   string `json:"kubeletVersion,omitempty"`
+	KubeProxyVersion string `json:"kubeProxyVersion,omitempty"`
+}
+
+type ClusterRegistrationTokenResponse struct {
+	Data []ClusterRegistrationTokenData `json:"data"`
+}
+
+// ClusterRegistrationTokenData is a token used to register custom nodes with
+// a Rancher cluster, together with the commands that run the agent.
+type ClusterRegistrationTokenData struct {
+	ID                  string `json:"id,omitempty"`
+	Name                string `json:"name,omitempty"`
+	ClusterID           string `json:"clusterId,omitempty"`
+	State               string `json:"state,omitempty"`
+	Token               string `json:"token,omitempty"`
+	NodeCommand         string `json:"nodeCommand,omitempty"`
+	WindowsNodeCommand  string `json:"windowsNodeCommand,omitempty"`
+	InsecureNodeCommand string `json:"insecureNodeCommand,omitempty"`
+}
+
+type NodePoolResponse struct {
+	Data []NodePoolData `json:"data"`
+}
+
+// NodePoolData is a node pool of a Rancher cluster.
+type NodePoolData struct {
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	ClusterID      string `json:"clusterId,omitempty"`
+	NodeTemplateID string `json:"nodeTemplateId,omitempty"`
+	HostnamePrefix string `json:"hostnamePrefix,omitempty"`
+	State          string `json:"state,omitempty"`
 }
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -73,6 +74,13 @@ type Amazonec2Config struct {
 	VpcID                   string   `json:"vpcId,omitempty"`
 	VpcIDRef                string   `json:"vpcIdRef,omitempty"`
 	Zone                    string   `json:"zone,omitempty"`
+
+	// SessionTokenSecretRef selects the AWS session token. It takes
+	// precedence over SessionToken.
+	SessionTokenSecretRef *xpv1.SecretKeySelector `json:"sessionTokenSecretRef,omitempty"`
+	// SSHKeyContentsSecretRef selects the private key used to access the
+	// instances. It takes precedence over SSHKeyContents.
+	SSHKeyContentsSecretRef *xpv1.SecretKeySelector `json:"sshKeyContentsSecretRef,omitempty"`
 }
 
 // RKE1NodeTemplateObservation are the observable fields of a RKE1NodeTemplate.
@@ -92,6 +100,22 @@ type RKE1NodeTemplateStatus struct {
 	AtProvider          RKE1NodeTemplateObservation `json:"atProvider,omitempty"`
 }
 
+// ReasonNodePoolsRemaining indicates the deletion of a node template is
+// blocked because node pools still use it.
+const ReasonNodePoolsRemaining xpv1.ConditionReason = "NodePoolsRemaining"
+
+// NodePoolsRemaining returns a condition that indicates the node template is
+// not deleted because the node pools listed in the message still use it.
+func NodePoolsRemaining(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNodePoolsRemaining,
+		Message:            msg,
+	}
+}
+
 // +kubebuilder:object:root=true
 
 // A RKE1NodeTemplate is an example API type.
@@ -101,6 +125,7 @@ type RKE1NodeTemplateStatus struct {
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+// +kubebuilder:storageversion
 type RKE1NodeTemplate struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
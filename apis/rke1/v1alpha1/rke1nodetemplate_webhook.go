@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// NodeDrivers are the node drivers a RKE1NodeTemplate can be configured for.
+var NodeDrivers = []string{"amazonec2"}
+
+// SetupWebhookWithManager registers the webhooks of RKE1NodeTemplate.
+func (in *RKE1NodeTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(in).Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rke1-rancher-crossplane-io-v1alpha1-rke1nodetemplate,mutating=false,failurePolicy=fail,groups=rke1.rancher.crossplane.io,resources=rke1nodetemplates,versions=v1alpha1,name=rke1nodetemplates.rke1.rancher.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+var _ webhook.Validator = &RKE1NodeTemplate{}
+
+// ValidateCreate rejects RKE1NodeTemplates Rancher could not provision nodes
+// from.
+func (in *RKE1NodeTemplate) ValidateCreate() error {
+	return in.validate()
+}
+
+// ValidateUpdate rejects changes that leave RKE1NodeTemplates Rancher could
+// not provision nodes from. Node templates being deleted are not validated, so
+// that their finalizers can always be removed.
+func (in *RKE1NodeTemplate) ValidateUpdate(old runtime.Object) error {
+	if in.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	if o, ok := old.(*RKE1NodeTemplate); ok && equality.Semantic.DeepEqual(in.Spec, o.Spec) {
+		return nil
+	}
+	return in.validate()
+}
+
+// ValidateDelete allows all deletions.
+func (in *RKE1NodeTemplate) ValidateDelete() error {
+	return nil
+}
+
+func (in *RKE1NodeTemplate) validate() error {
+	var errs field.ErrorList
+	p := in.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider")
+	if p.Driver != "" && !contains(NodeDrivers, p.Driver) {
+		errs = append(errs, field.NotSupported(path.Child("driver"), p.Driver, NodeDrivers))
+	}
+	ec2 := p.Amazonec2Config
+	ec2Path := path.Child("amazonec2Config")
+	if ec2.SubnetID != "" && ec2.SubnetIDRef != "" {
+		errs = append(errs, field.Forbidden(ec2Path.Child("subnetIdRef"), "subnetId and subnetIdRef are mutually exclusive"))
+	}
+	if p.Driver == "amazonec2" && ec2.Region == "" {
+		errs = append(errs, field.Required(ec2Path.Child("region"), "region is required by the amazonec2 driver"))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(RKE1NodeTemplateGroupVersionKind.GroupKind(), in.Name, errs)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// The defaults below mirror the norman default tags of the RKE configuration,
+// which Rancher applies to the fields left empty. Non-pointer booleans
+// defaulting to true, such as preventSinglePointFailure, cannot be told apart
+// from an explicit false and are left to Rancher. Optional sections are only
+// defaulted when they are set.
+
+// SetDefaults sets the fields of the RKE configuration left empty to the
+// values Rancher would use.
+func (in *RancherKubernetesEngineConfig) SetDefaults() {
+	defaultBool(&in.IgnoreDockerVersion, true)
+	defaultBool(&in.EnableCRIDockerd, false)
+	defaultInt(&in.AddonJobTimeout, 45)
+	defaultString(&in.Network.Plugin, "canal")
+	if aci := in.Network.AciNetworkProvider; aci != nil {
+		defaultString(&aci.ApicRefreshTime, "1200")
+	}
+	defaultString(&in.Authentication.Strategy, "x509")
+	defaultString(&in.Ingress.Provider, "nginx")
+	defaultBool(&in.Ingress.DefaultBackend, true)
+	defaultBool(&in.Ingress.DefaultIngressClass, true)
+	defaultString(&in.Monitoring.Provider, "metrics-server")
+	if in.Monitoring.Replicas == nil {
+		replicas := int32(1)
+		in.Monitoring.Replicas = &replicas
+	}
+	defaultString(&in.Services.KubeAPI.ServiceNodePortRange, "30000-32767")
+	in.Services.Etcd.SetDefaults()
+	if in.DNS != nil && in.DNS.LinearAutoscalerParams != nil {
+		p := in.DNS.LinearAutoscalerParams
+		defaultString(&p.CoresPerReplica, "128")
+		defaultString(&p.NodesPerReplica, "4")
+		defaultInt(&p.Min, 1)
+	}
+	if in.UpgradeStrategy != nil {
+		in.UpgradeStrategy.SetDefaults()
+	}
+}
+
+// SetDefaults sets the fields of the etcd service left empty to the values
+// Rancher would use.
+func (in *ETCDService) SetDefaults() {
+	defaultBool(&in.Snapshot, false)
+	defaultString(&in.Retention, "72h")
+	defaultString(&in.Creation, "12h")
+	if b := in.BackupConfig; b != nil {
+		defaultBool(&b.Enabled, true)
+		defaultInt(&b.IntervalHours, 12)
+		defaultInt(&b.Retention, 6)
+		defaultInt(&b.Timeout, 300)
+	}
+}
+
+// SetDefaults sets the fields of the upgrade strategy left empty to the
+// values Rancher would use.
+func (in *NodeUpgradeStrategy) SetDefaults() {
+	defaultString(&in.MaxUnavailableWorker, "10%")
+	defaultString(&in.MaxUnavailableControlplane, "1")
+	if in.DrainInput != nil {
+		in.DrainInput.SetDefaults()
+	}
+}
+
+// SetDefaults sets the fields of the drain input left empty to the values
+// Rancher would use.
+func (in *NodeDrainInput) SetDefaults() {
+	defaultBool(&in.IgnoreDaemonSets, true)
+	defaultInt(&in.GracePeriod, -1)
+	defaultInt(&in.Timeout, 120)
+}
+
+func defaultString(s *string, v string) {
+	if *s == "" {
+		*s = v
+	}
+}
+
+func defaultInt(i *int, v int) {
+	if *i == 0 {
+		*i = v
+	}
+}
+
+func defaultBool(b **bool, v bool) {
+	if *b == nil {
+		*b = &v
+	}
+}
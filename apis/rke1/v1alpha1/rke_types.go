@@ -4,6 +4,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
 type LimitType string
@@ -91,6 +93,8 @@ type BastionHost struct {
 	SSHAgentAuth bool `yaml:"ssh_agent_auth,omitempty" json:"sshAgentAuth,omitempty"`
 	// SSH Private Key
 	SSHKey string `yaml:"ssh_key" json:"sshKey,omitempty" norman:"type=password"`
+	// SSH Private Key, read from a secret. It takes precedence over SSHKey.
+	SSHKeySecretRef *xpv1.SecretKeySelector `yaml:"-" json:"sshKeySecretRef,omitempty"`
 	// SSH Private Key Path
 	SSHKeyPath string `yaml:"ssh_key_path" json:"sshKeyPath,omitempty"`
 	// SSH Certificate
@@ -108,6 +112,9 @@ type PrivateRegistry struct {
 	User string `yaml:"user" json:"user,omitempty"`
 	// Password for registry access
 	Password string `yaml:"password" json:"password,omitempty" norman:"type=password"`
+	// Password for registry access, read from a secret. It takes precedence
+	// over Password.
+	PasswordSecretRef *xpv1.SecretKeySelector `yaml:"-" json:"passwordSecretRef,omitempty"`
 	// Default registry
 	IsDefault bool `yaml:"is_default" json:"isDefault,omitempty"`
 	// ECRCredentialPlugin
@@ -220,6 +227,8 @@ type RKEConfigNode struct {
 	SSHAgentAuth bool `yaml:"ssh_agent_auth,omitempty" json:"sshAgentAuth,omitempty"`
 	// SSH Private Key
 	SSHKey string `yaml:"ssh_key" json:"sshKey,omitempty" norman:"type=password"`
+	// SSH Private Key, read from a secret. It takes precedence over SSHKey.
+	SSHKeySecretRef *xpv1.SecretKeySelector `yaml:"-" json:"sshKeySecretRef,omitempty"`
 	// SSH Private Key Path
 	SSHKeyPath string `yaml:"ssh_key_path" json:"sshKeyPath,omitempty"`
 	// SSH Certificate
@@ -305,6 +314,8 @@ type S3BackupConfig struct {
 	AccessKey string `yaml:"access_key" json:"accessKey,omitempty"`
 	// Secret access key
 	SecretKey string `yaml:"secret_key" json:"secretKey,omitempty" norman:"type=password" `
+	// Secret access key, read from a secret. It takes precedence over SecretKey.
+	SecretKeySecretRef *xpv1.SecretKeySelector `yaml:"-" json:"secretKeySecretRef,omitempty"`
 	// name of the bucket to use for backup
 	BucketName string `yaml:"bucket_name" json:"bucketName,omitempty"`
 	// AWS Region, AWS spcific
@@ -657,6 +668,9 @@ type CanalNetworkProvider struct {
 
 type WeaveNetworkProvider struct {
 	Password string `yaml:"password,omitempty" json:"password,omitempty" norman:"type=password"`
+	// PasswordSecretRef selects the password used to encrypt weave traffic.
+	// It takes precedence over Password.
+	PasswordSecretRef *xpv1.SecretKeySelector `yaml:"-" json:"passwordSecretRef,omitempty"`
 }
 
 type AciNetworkProvider struct {
@@ -734,6 +748,10 @@ type AciNetworkProvider struct {
 	SriovEnable                       string   `yaml:"sriov_enable,omitempty" json:"sriovEnable,omitempty"`
 	MultusDisable                     string   `yaml:"multus_disable,omitempty" json:"multusDisable,omitempty"`
 	UseClusterRole                    string   `yaml:"use_cluster_role,omitempty" json:"useClusterRole,omitempty"`
+
+	// TokenSecretRef selects the token used to authenticate with the APIC.
+	// It takes precedence over Token.
+	TokenSecretRef *xpv1.SecretKeySelector `yaml:"-" json:"tokenSecretRef,omitempty"`
 }
 
 type KubernetesServicesOptions struct {
@@ -0,0 +1,320 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// localClusterAuthEndpoint is the local cluster auth endpoint.
+type LocalClusterAuthEndpoint struct {
+	Enabled *bool  `json:"enabled,omitempty"`
+	FQDN    string `json:"fqdn,omitempty"`
+}
+
+// RKENodePoolSpec defines the desired state of RKENodePool
+type RKENodePool struct {
+	Annotations             map[string]string `json:"annotations,omitempty"`
+	BaseType                string            `json:"baseType,omitempty"`
+	ClusterID               string            `json:"clusterId,omitempty"`
+	ControlPlane            *bool             `json:"controlPlane,omitempty"`
+	DeleteNotReadyAfterSecs int64             `json:"deleteNotReadyAfterSecs,omitempty"`
+	DrainBeforeDelete       *bool             `json:"drainBeforeDelete,omitempty"`
+	Driver                  string            `json:"driver,omitempty"`
+	ETCD                    *bool             `json:"etcd,omitempty"`
+	HostnamePrefix          string            `json:"hostnamePrefix,omitempty"`
+	Labels                  map[string]string `json:"labels,omitempty"`
+	Name                    string            `json:"name,omitempty"`
+	NodeTemplateID          string            `json:"nodeTemplateId,omitempty"`
+	NodeTemplateIDRef       string            `json:"nodeTemplateIdRef,omitempty"`
+	Quantity                int64             `json:"quantity,omitempty"`
+	Worker                  *bool             `json:"worker,omitempty"`
+}
+
+// RKEClusterConfigSpec defines the desired state of RKEClusterConfig
+type RKEClusterConfigSpec struct {
+	RKEClusterSpec           RancherKubernetesEngineConfig `json:"rancherKubernetesEngineConfig,omitempty"`
+	DockerRootDir            string                        `json:"dockerRootDir,omitempty"`
+	EnableClusterAlerting    *bool                         `json:"enableClusterAlerting,omitempty"`
+	EnableClusterMonitoring  *bool                         `json:"enableClusterMonitoring,omitempty"`
+	EnableNetworkPolicy      *bool                         `json:"enableNetworkPolicy,omitempty"`
+	Labels                   map[string]string             `json:"labels,omitempty"`
+	LocalClusterAuthEndpoint LocalClusterAuthEndpoint      `json:"localClusterAuthEndpoint,omitempty"`
+	Name                     string                        `json:"name,omitempty"`
+	// DefaultPodSecurityAdmissionConfigurationTemplateName is the Pod
+	// Security Admission template applied to the cluster, e.g.
+	// rancher-restricted.
+	DefaultPodSecurityAdmissionConfigurationTemplateName string `json:"defaultPodSecurityAdmissionConfigurationTemplateName,omitempty"`
+}
+
+// ClusterParameters are the configurable fields of a Cluster.
+type ClusterParameters struct {
+	KubeconfigSecretNamespace string               `json:"kubeconfigSecretNamespace,omitempty"`
+	Region                    string               `json:"region,omitempty"`
+	RKE                       RKEClusterConfigSpec `json:"rke,omitempty"`
+	// NodePools provision the nodes of the cluster with node templates. A
+	// cluster without node pools is a custom cluster: its nodes register by
+	// running the node commands published as connection details. Pools are
+	// identified by their hostname prefix; pools missing in Rancher are
+	// created whenever the cluster is reconciled.
+	NodePools []RKENodePool `json:"nodePools,omitempty"`
+
+	// ClusterTemplateRevisionID is the Rancher ID of the cluster template
+	// revision the cluster is created from. RKE is ignored when set.
+	ClusterTemplateRevisionID string `json:"clusterTemplateRevisionId,omitempty"`
+	// ClusterTemplateRevisionRef is the name of a ClusterTemplateRevision. It
+	// is resolved to ClusterTemplateRevisionID.
+	ClusterTemplateRevisionRef string `json:"clusterTemplateRevisionRef,omitempty"`
+	// Answers to the questions of the cluster template revision, keyed by
+	// variable.
+	Answers map[string]string `json:"answers,omitempty"`
+
+	// CertificateRotation rotates the certificates of the cluster whenever its
+	// generation is increased.
+	CertificateRotation *CertificateRotation `json:"certificateRotation,omitempty"`
+
+	// Upgrade configures how changes of the Kubernetes version in RKE are
+	// rolled out.
+	Upgrade *UpgradePolicy `json:"upgrade,omitempty"`
+
+	// NodeMaintenance cordons, drains or deletes individual nodes of the
+	// cluster. Nodes that are not listed are left as they are.
+	NodeMaintenance []NodeMaintenance `json:"nodeMaintenance,omitempty"`
+
+	// DeletionProtection refuses to delete the cluster from Rancher while
+	// true. Setting the deletion-protection annotation to "true" has the
+	// same effect.
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+	// Deletion configures how the cluster is deleted from Rancher.
+	Deletion *DeletionPolicy `json:"deletion,omitempty"`
+}
+
+// DeletionPolicy configures how a cluster is deleted from Rancher.
+type DeletionPolicy struct {
+	// FinalSnapshot takes an etcd snapshot of the cluster and waits for it to
+	// complete before the cluster is deleted. The snapshot only outlives the
+	// cluster when etcd backups are stored in S3.
+	FinalSnapshot bool `json:"finalSnapshot,omitempty"`
+	// Force deletes the cluster even though it still runs workloads outside
+	// of the system namespaces, or when its workloads cannot be listed.
+	Force bool `json:"force,omitempty"`
+}
+
+// NodeMaintenance is the desired state of an individual node of a cluster.
+type NodeMaintenance struct {
+	// Node is the name, hostname or Rancher ID of the node. Delete only
+	// matches the Rancher ID, so that a node replacing a deleted node is not
+	// deleted as well.
+	// +kubebuilder:validation:MinLength=1
+	Node string `json:"node"`
+	// Cordon marks the node unschedulable. The node is uncordoned when
+	// neither Cordon nor Drain is set.
+	Cordon bool `json:"cordon,omitempty"`
+	// Drain cordons the node and evicts its pods with the supplied options.
+	Drain *NodeDrainInput `json:"drain,omitempty"`
+	// Delete removes the node from the cluster. Rancher replaces a deleted
+	// node of a node pool with a new node.
+	Delete bool `json:"delete,omitempty"`
+}
+
+// UpgradePolicy configures how changes of the Kubernetes version of a cluster
+// are rolled out. The nodes are upgraded following the upgradeStrategy of the
+// RKE configuration.
+type UpgradePolicy struct {
+	// SnapshotBeforeUpgrade takes an etcd snapshot of the cluster and waits
+	// for it to complete before the upgrade starts.
+	SnapshotBeforeUpgrade *bool `json:"snapshotBeforeUpgrade,omitempty"`
+	// Timeout after which an upgrade that has not completed is marked with
+	// the UpgradeFailed condition. Defaults to 1h.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// CertificateRotation is a request to rotate the certificates of a cluster.
+type CertificateRotation struct {
+	// Generation of the rotation. The certificates are rotated when it is
+	// greater than the generation last rotated; the generation set when the
+	// cluster is created does not rotate.
+	// +kubebuilder:validation:Minimum=0
+	Generation int64 `json:"generation"`
+	// CACertificates also rotates the CA certificates. This restarts all
+	// services of the cluster.
+	CACertificates *bool `json:"caCertificates,omitempty"`
+	// Service whose certificates are rotated. All services are rotated when
+	// empty.
+	// +kubebuilder:validation:Enum=etcd;kubelet;kube-apiserver;kube-proxy;kube-scheduler;kube-controller-manager
+	Service string `json:"service,omitempty"`
+}
+
+// ClusterObservation are the observable fields of a Cluster.
+type ClusterObservation struct {
+	ID string `json:"id,omitempty"`
+	// EtcdSnapshots are the etcd snapshots of the cluster, both recurring and
+	// on-demand.
+	EtcdSnapshots []ClusterEtcdSnapshot `json:"etcdSnapshots,omitempty"`
+	// CertificateRotation is the state of the last certificate rotation.
+	CertificateRotation *CertificateRotationStatus `json:"certificateRotation,omitempty"`
+	// KubernetesVersion is the Kubernetes version configured in Rancher.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// Upgrade is the state of the last Kubernetes upgrade.
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+	// FinalSnapshotID is the Rancher ID of the etcd backup taken before the
+	// cluster is deleted.
+	FinalSnapshotID string `json:"finalSnapshotId,omitempty"`
+	// Nodes are the nodes of the cluster.
+	Nodes []ClusterNode `json:"nodes,omitempty"`
+	// NodePools are the node pools of the cluster.
+	NodePools []ClusterNodePool `json:"nodePools,omitempty"`
+	// RegisteredNodes counts the custom nodes registered with a cluster
+	// without node pools.
+	RegisteredNodes *RegisteredNodes `json:"registeredNodes,omitempty"`
+}
+
+// ClusterNodePool is a node pool of a cluster. Node pools are identified by
+// their hostname prefix.
+type ClusterNodePool struct {
+	// ID is the Rancher ID of the node pool.
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	HostnamePrefix string `json:"hostnamePrefix"`
+	NodeTemplateID string `json:"nodeTemplateId,omitempty"`
+	State          string `json:"state,omitempty"`
+}
+
+// ClusterNode is a node of a cluster.
+type ClusterNode struct {
+	// ID is the Rancher ID of the node.
+	ID       string `json:"id"`
+	Name     string `json:"name,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+	// NodePoolID is the Rancher ID of the node pool of the node, if any.
+	NodePoolID        string   `json:"nodePoolId,omitempty"`
+	Roles             []string `json:"roles,omitempty"`
+	State             string   `json:"state,omitempty"`
+	IPAddress         string   `json:"ipAddress,omitempty"`
+	ExternalIPAddress string   `json:"externalIpAddress,omitempty"`
+	KubeletVersion    string   `json:"kubeletVersion,omitempty"`
+	Unschedulable     bool     `json:"unschedulable,omitempty"`
+}
+
+// RegisteredNodes counts the nodes registered with a custom cluster by role. A
+// node with several roles is counted for each of them.
+type RegisteredNodes struct {
+	Total        int `json:"total"`
+	Etcd         int `json:"etcd"`
+	ControlPlane int `json:"controlPlane"`
+	Worker       int `json:"worker"`
+	// Active is the number of nodes that are active.
+	Active int `json:"active"`
+}
+
+// UpgradeStatus is the state of a Kubernetes upgrade of a cluster.
+type UpgradeStatus struct {
+	FromVersion string `json:"fromVersion,omitempty"`
+	ToVersion   string `json:"toVersion"`
+	// SnapshotID is the Rancher ID of the etcd backup taken before the
+	// upgrade.
+	SnapshotID  string       `json:"snapshotId,omitempty"`
+	StartedAt   *metav1.Time `json:"startedAt,omitempty"`
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Nodes is the progress of the upgrade per node.
+	Nodes []NodeUpgradeStatus `json:"nodes,omitempty"`
+}
+
+// NodeUpgradeStatus is the progress of a Kubernetes upgrade on a node.
+type NodeUpgradeStatus struct {
+	Name           string `json:"name"`
+	State          string `json:"state,omitempty"`
+	KubeletVersion string `json:"kubeletVersion,omitempty"`
+	// Upgraded is true once the kubelet of the node runs the new version.
+	Upgraded bool `json:"upgraded"`
+}
+
+// CertificateRotationStatus is the state of the last certificate rotation of
+// a cluster.
+type CertificateRotationStatus struct {
+	// Generation last rotated.
+	Generation int64 `json:"generation"`
+	// RequestedAt is when the last rotation was requested.
+	RequestedAt *metav1.Time `json:"requestedAt,omitempty"`
+	// LastRotationTime is when the last rotation completed.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+}
+
+// ClusterEtcdSnapshot is an etcd snapshot of a cluster.
+type ClusterEtcdSnapshot struct {
+	// ID is the Rancher ID of the etcd backup, usable as etcdBackupId of an
+	// EtcdSnapshotRestore.
+	ID       string `json:"id"`
+	Filename string `json:"filename,omitempty"`
+	Manual   *bool  `json:"manual,omitempty"`
+	State    string `json:"state,omitempty"`
+	Created  string `json:"created,omitempty"`
+}
+
+// A ClusterSpec defines the desired state of a Cluster.
+type ClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterParameters `json:"forProvider"`
+}
+
+// A ClusterStatus represents the observed state of a Cluster.
+type ClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Cluster is an example API type.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rancher}
+type RKE1Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Cluster
+type RKE1ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RKE1Cluster `json:"items"`
+}
+
+// Cluster type metadata.
+var (
+	ClusterKind             = reflect.TypeOf(RKE1Cluster{}).Name()
+	ClusterGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterKind}.String()
+	ClusterKindAPIVersion   = ClusterKind + "." + SchemeGroupVersion.String()
+	ClusterGroupVersionKind = SchemeGroupVersion.WithKind(ClusterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RKE1Cluster{}, &RKE1ClusterList{})
+}
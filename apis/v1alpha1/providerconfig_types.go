@@ -31,6 +31,21 @@ type ProviderConfigSpec struct {
 	Credentials ProviderCredentials `json:"credentials"`
 	RancherHost string              `json:"rancherHost"`
 	AWScreds    AWScreds            `json:"awsCreds,omitempty"`
+	// RateLimit limits the requests sent to the Rancher host. The limit is
+	// shared by all ProviderConfigs of the same host. Requests are not
+	// limited when unset.
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+}
+
+// RateLimit is a token bucket limiting the requests sent to a Rancher host.
+type RateLimit struct {
+	// QPS is the number of requests per second sent on average.
+	// +kubebuilder:validation:Minimum=1
+	QPS int32 `json:"qps"`
+	// Burst is the number of requests that may be sent at once. Defaults to
+	// QPS.
+	// +kubebuilder:validation:Minimum=0
+	Burst int32 `json:"burst,omitempty"`
 }
 
 // AWScreds contains the credentials for AWS
@@ -38,8 +38,10 @@ import (
 
 	"github.com/dormullor/provider-rancher/apis"
 	"github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
 	rancher "github.com/dormullor/provider-rancher/internal/controller"
 	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/webhook"
 )
 
 func main() {
@@ -50,10 +52,16 @@ func main() {
 
 		syncInterval     = app.Flag("sync", "How often all resources will be double-checked for drift from the desired state.").Short('s').Default("1h").Duration()
 		pollInterval     = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Default("1m").Duration()
+		resyncInterval   = app.Flag("rancher-resync", "How often the Rancher collections shared by the resources of a ProviderConfig are listed. Resources whose Rancher objects changed are reconciled right away.").Default("30s").Envar("RANCHER_RESYNC").Duration()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
+
+		webhookTLSCertDir = app.Flag("webhook-tls-cert-dir", "The directory of the TLS certificate of the webhook server, containing tls.crt and tls.key. Admission webhooks are disabled when empty; the conversion webhook is always served, with a self-signed certificate if necessary.").Envar("WEBHOOK_TLS_CERT_DIR").String()
+		webhookHost       = app.Flag("webhook-host", "Additional DNS name of the webhook server. A self-signed certificate for it and the webhook service is generated when the certificate directory is empty.").Default("localhost").Envar("WEBHOOK_HOST").String()
+		webhookPort       = app.Flag("webhook-port", "The port the webhook server listens on.").Default("9443").Envar("WEBHOOK_PORT").Int()
+		webhookService    = app.Flag("webhook-service", "Name of the service in the provider's namespace the webhook server is reached through. The webhook configurations are pointed at it when a self-signed certificate is used; otherwise Crossplane configures them when it installs the provider.").Default("provider-rancher").Envar("WEBHOOK_SERVICE").String()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -69,6 +77,16 @@ func main() {
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
+	// The CRDs of resources served in several API versions are converted by
+	// the webhook server, so it is started even when admission webhooks are
+	// disabled.
+	certDir := *webhookTLSCertDir
+	if certDir == "" {
+		certDir = filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs")
+	}
+	caBundle, err := webhook.EnsureCertificate(certDir, *webhookService+"."+*namespace+".svc", *webhookHost)
+	kingpin.FatalIfError(err, "Cannot ensure webhook certificate")
+
 	mgr, err := ctrl.NewManager(ratelimiter.LimitRESTConfig(cfg, *maxReconcileRate), ctrl.Options{
 		SyncPeriod: syncInterval,
 
@@ -84,6 +102,9 @@ func main() {
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+
+		Port:    *webhookPort,
+		CertDir: certDir,
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add Rancher APIs to scheme")
@@ -115,6 +136,20 @@ func main() {
 		})), "cannot create default store config")
 	}
 
-	kingpin.FatalIfError(rancher.Setup(mgr, o), "Cannot setup Rancher controllers")
+	lists := cache.New(*resyncInterval, log)
+	kingpin.FatalIfError(mgr.Add(lists), "Cannot add Rancher list cache")
+	kingpin.FatalIfError(rancher.Setup(mgr, o, lists), "Cannot setup Rancher controllers")
+	svc := webhook.Service{Name: *webhookService, Namespace: *namespace, Port: int32(*webhookPort)}
+	if *webhookTLSCertDir != "" {
+		if caBundle != nil {
+			kingpin.FatalIfError(webhook.InjectClientConfig(context.Background(), cfg, svc, caBundle), "Cannot configure webhooks")
+		}
+		kingpin.FatalIfError(webhook.Setup(mgr), "Cannot setup webhooks")
+	} else {
+		if caBundle != nil {
+			kingpin.FatalIfError(webhook.InjectConversionClientConfig(context.Background(), cfg, svc, caBundle), "Cannot configure conversion webhook")
+		}
+		kingpin.FatalIfError(webhook.SetupConversion(mgr), "Cannot setup conversion webhook")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
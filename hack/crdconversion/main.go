@@ -0,0 +1,89 @@
+//go:build generate
+// +build generate
+
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// crdconversion points the supplied manifests at the webhook server of the
+// provider. CRD manifests are configured to convert between their versions
+// with the conversion webhook, while the placeholder service controller-gen
+// generates for webhook configurations is replaced. The service is the one
+// Crossplane creates for the provider when it is installed as a package named
+// provider-rancher; the provider points the webhooks at its actual service
+// when it is deployed otherwise, see the --webhook-service flag.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// placeholder is the service controller-gen generates for webhook
+	// configurations.
+	placeholder = `      name: webhook-service
+      namespace: system
+`
+
+	service = `      name: provider-rancher
+      namespace: crossplane-system
+      port: 9443
+`
+
+	conversion = `  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        service:
+          name: provider-rancher
+          namespace: crossplane-system
+          path: /convert
+          port: 9443
+      conversionReviewVersions:
+      - v1
+`
+)
+
+func main() {
+	for _, path := range os.Args[1:] {
+		if err := patch(path); err != nil {
+			fmt.Fprintf(os.Stderr, "cannot patch %s: %v\n", path, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func patch(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	manifest := string(b)
+	if !strings.Contains(manifest, "\nkind: CustomResourceDefinition\n") {
+		manifest = strings.ReplaceAll(manifest, placeholder, service)
+		return os.WriteFile(path, []byte(manifest), 0o644) // nolint:gosec // Manifests are not secret.
+	}
+	if strings.Contains(manifest, "\n  conversion:\n") {
+		return nil
+	}
+	i := strings.Index(manifest, "\nspec:\n")
+	if i < 0 {
+		return fmt.Errorf("no spec found")
+	}
+	i += len("\nspec:\n")
+	return os.WriteFile(path, []byte(manifest[:i]+conversion+manifest[i:]), 0o644) // nolint:gosec // Manifests are not secret.
+}
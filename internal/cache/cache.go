@@ -0,0 +1,366 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache shares the Rancher collections managed resources are observed
+// from between all resources of a ProviderConfig.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	managementv1alpha1 "github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+// A Collection is a Rancher v3 API collection.
+type Collection string
+
+// Collections shared by the controllers.
+const (
+	Clusters                    Collection = "clusters"
+	NodeTemplates               Collection = "nodetemplates"
+	Projects                    Collection = "projects"
+	ClusterRoleTemplateBindings Collection = util.ClusterRoleTemplateBindings
+	ProjectRoleTemplateBindings Collection = util.ProjectRoleTemplateBindings
+	GlobalRoleBindings          Collection = util.GlobalRoleBindings
+)
+
+// idleTimeout is how long a collection that is no longer read keeps being
+// listed, e.g. after the credentials of a ProviderConfig changed.
+const idleTimeout = 10 * time.Minute
+
+// eventBuffer is the number of change events buffered per subscriber. Events
+// are dropped when the buffer is full; the resources are then reconciled
+// when they are next polled.
+const eventBuffer = 1024
+
+// A Key identifies the Rancher server and credentials of a ProviderConfig.
+type Key struct {
+	Host  string
+	Token string
+}
+
+type informerKey struct {
+	Key
+	collection Collection
+}
+
+type subscriber struct {
+	events  chan event.GenericEvent
+	objects MapFunc
+}
+
+// A Cache lists Rancher collections once per interval and ProviderConfig,
+// instead of once per managed resource and poll. The managed resources whose
+// Rancher objects changed between two lists are reconciled through the
+// sources returned by Subscribe. A Cache is a manager.Runnable; collections
+// are only listed in the background once the manager has started it.
+type Cache struct {
+	interval time.Duration
+	log      logging.Logger
+
+	mu          sync.Mutex
+	ctx         context.Context
+	informers   map[informerKey]*informer
+	subscribers map[Collection][]subscriber
+}
+
+// New returns a Cache that lists each collection once per interval.
+func New(interval time.Duration, log logging.Logger) *Cache {
+	return &Cache{
+		interval:    interval,
+		log:         log,
+		informers:   map[informerKey]*informer{},
+		subscribers: map[Collection][]subscriber{},
+	}
+}
+
+// Start lists the collections read from the Cache in the background until
+// ctx is done.
+func (c *Cache) Start(ctx context.Context) error {
+	c.mu.Lock()
+	c.ctx = ctx
+	for _, i := range c.informers {
+		go i.run(ctx)
+	}
+	c.mu.Unlock()
+	<-ctx.Done()
+	return nil
+}
+
+// Subscribe returns a source of events for the managed resources whose
+// Rancher objects in the collection were added, changed or removed. objects
+// returns the managed resources of a Rancher object. Subscribe must be called
+// before the manager is started.
+func (c *Cache) Subscribe(col Collection, objects MapFunc) source.Source {
+	events := make(chan event.GenericEvent, eventBuffer)
+	c.mu.Lock()
+	c.subscribers[col] = append(c.subscribers[col], subscriber{events: events, objects: objects})
+	c.mu.Unlock()
+	return &source.Channel{Source: events}
+}
+
+// List decodes the latest list of the collection into out, which must point
+// to a Rancher collection response. A collection that is not cached yet, or
+// whose last list is outdated, is listed before List returns.
+func (c *Cache) List(ctx context.Context, key Key, col Collection, httpClient http.Client, out interface{}) error {
+	body, err := c.informer(key, col, httpClient).get(ctx)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, out)
+}
+
+// ClusterIDByName returns the ID of the cluster with the supplied name. The
+// clusters are listed again if no cluster has the name, so that clusters
+// created since the latest list are found.
+func (c *Cache) ClusterIDByName(ctx context.Context, key Key, httpClient http.Client, name string) (string, error) {
+	for _, refresh := range []bool{false, true} {
+		if refresh {
+			c.Invalidate(key, Clusters)
+		}
+		var clusters struct {
+			Data []struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"data"`
+		}
+		if err := c.List(ctx, key, Clusters, httpClient, &clusters); err != nil {
+			return "", err
+		}
+		for _, cl := range clusters.Data {
+			if cl.Name == name {
+				return cl.ID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("cluster %q not found", name)
+}
+
+// ProjectByName returns the project with the supplied name in the supplied
+// cluster, or nil if no such project exists.
+func (c *Cache) ProjectByName(ctx context.Context, key Key, httpClient http.Client, clusterID, name string) (*managementv1alpha1.ProjectData, error) {
+	projects := &managementv1alpha1.ProjectResponse{}
+	if err := c.List(ctx, key, Projects, httpClient, projects); err != nil {
+		return nil, err
+	}
+	return util.FindProject(projects.Data, clusterID, name), nil
+}
+
+// FindRoleBinding returns the first binding of the supplied collection that
+// matches every field set in desired, or nil if there is none.
+func (c *Cache) FindRoleBinding(ctx context.Context, key Key, col Collection, httpClient http.Client, desired managementv1alpha1.RoleBindingData) (*managementv1alpha1.RoleBindingData, error) {
+	bindings := &managementv1alpha1.RoleBindingResponse{}
+	if err := c.List(ctx, key, col, httpClient, bindings); err != nil {
+		return nil, err
+	}
+	matches, err := util.MatchRoleBindings(bindings.Data, desired)
+	if err != nil || len(matches) == 0 {
+		return nil, err
+	}
+	return &matches[0], nil
+}
+
+// Invalidate makes the next List of the collection list it again. It is called
+// after changing the collection, so that the change is observed right away.
+func (c *Cache) Invalidate(key Key, col Collection) {
+	c.mu.Lock()
+	i, ok := c.informers[informerKey{Key: key, collection: col}]
+	c.mu.Unlock()
+	if ok {
+		i.invalidate()
+	}
+}
+
+// informer returns the informer of a collection, creating it if necessary.
+func (c *Cache) informer(key Key, col Collection, httpClient http.Client) *informer {
+	k := informerKey{Key: key, collection: col}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if i, ok := c.informers[k]; ok {
+		return i
+	}
+	i := &informer{cache: c, key: k, httpClient: httpClient, lastUsed: time.Now()}
+	c.informers[k] = i
+	if c.ctx != nil {
+		go i.run(c.ctx)
+	}
+	return i
+}
+
+// remove removes an idle informer.
+func (c *Cache) remove(i *informer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.informers[i.key] == i {
+		delete(c.informers, i.key)
+	}
+}
+
+// notify sends an event for each managed resource of a changed Rancher object
+// to the subscribers of the collection.
+func (c *Cache) notify(ctx context.Context, col Collection, changed []Object) {
+	c.mu.Lock()
+	subs := c.subscribers[col]
+	c.mu.Unlock()
+	for _, s := range subs {
+		for _, o := range changed {
+			for _, obj := range s.objects(ctx, o) {
+				select {
+				case s.events <- event.GenericEvent{Object: obj}:
+				default:
+				}
+			}
+		}
+	}
+}
+
+// An informer keeps the latest list of a collection.
+type informer struct {
+	cache      *Cache
+	key        informerKey
+	httpClient http.Client
+
+	// listMu serializes lists, so that concurrent reads of an outdated
+	// collection list it only once.
+	listMu sync.Mutex
+
+	mu       sync.Mutex
+	body     []byte
+	items    map[string]item
+	listedAt time.Time
+	lastUsed time.Time
+}
+
+// An item is a Rancher object and its raw JSON.
+type item struct {
+	Object
+	raw string
+}
+
+// get returns the latest list of the collection, listing it first when it is
+// outdated. A list is outdated after two intervals, which only happens when
+// the background lists fail or have not started yet, or once it has been
+// invalidated.
+func (i *informer) get(ctx context.Context) ([]byte, error) {
+	i.mu.Lock()
+	i.lastUsed = time.Now()
+	i.mu.Unlock()
+
+	i.listMu.Lock()
+	defer i.listMu.Unlock()
+	i.mu.Lock()
+	body, fresh := i.body, time.Since(i.listedAt) < 2*i.cache.interval
+	i.mu.Unlock()
+	if body != nil && fresh {
+		return body, nil
+	}
+	return i.list(ctx)
+}
+
+// invalidate outdates the latest list.
+func (i *informer) invalidate() {
+	i.mu.Lock()
+	i.listedAt = time.Time{}
+	i.mu.Unlock()
+}
+
+// list lists the collection and notifies the subscribers of the Rancher
+// objects that changed since the previous list. listMu must be held.
+func (i *informer) list(ctx context.Context) ([]byte, error) {
+	body, err := util.ListCollection(i.key.Host, i.key.Token, string(i.key.collection), i.httpClient, ctx)
+	if err != nil {
+		return nil, err
+	}
+	var list struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, err
+	}
+	items := make(map[string]item, len(list.Data))
+	for _, raw := range list.Data {
+		var o struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(raw, &o); err != nil {
+			return nil, err
+		}
+		items[o.ID] = item{Object: Object{ID: o.ID, Name: o.Name}, raw: string(raw)}
+	}
+
+	i.mu.Lock()
+	previous := i.items
+	i.body, i.items, i.listedAt = body, items, time.Now()
+	i.mu.Unlock()
+
+	// Nothing changed with the first list, since all resources are
+	// reconciled when their controller starts.
+	if previous == nil {
+		return body, nil
+	}
+	var changed []Object
+	for id, it := range items {
+		if p, ok := previous[id]; !ok || p.raw != it.raw {
+			changed = append(changed, it.Object)
+		}
+	}
+	for id, p := range previous {
+		if _, ok := items[id]; !ok {
+			changed = append(changed, p.Object)
+		}
+	}
+	i.cache.notify(ctx, i.key.collection, changed)
+	return body, nil
+}
+
+// run lists the collection once per interval until ctx is done or the
+// collection has not been read for idleTimeout.
+func (i *informer) run(ctx context.Context) {
+	t := time.NewTicker(i.cache.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		i.mu.Lock()
+		idle := time.Since(i.lastUsed) > idleTimeout
+		i.mu.Unlock()
+		if idle {
+			i.cache.remove(i)
+			return
+		}
+		i.listMu.Lock()
+		_, err := i.list(ctx)
+		i.listMu.Unlock()
+		if err != nil {
+			i.cache.log.Debug("Cannot list Rancher collection", "collection", i.key.collection, "host", i.key.Host, "error", err)
+		}
+	}
+}
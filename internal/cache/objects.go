@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+)
+
+// An Object is a Rancher object of a collection.
+type Object struct {
+	ID   string
+	Name string
+}
+
+// A MapFunc returns the managed resources of a Rancher object.
+type MapFunc func(ctx context.Context, o Object) []client.Object
+
+// An IDFunc returns the Rancher ID of the object of a managed resource, or an
+// empty string if it is not known yet.
+type IDFunc func(mg client.Object) string
+
+// ByID returns a MapFunc that maps a Rancher object to the managed resources
+// in list whose Rancher ID is the ID of the object. Managed resources whose
+// ID is not known yet are matched by name instead. The managed resources are
+// read from kube, which is expected to be the cached client of the manager.
+// When they cannot be read, they are reconciled when they are next polled.
+func ByID(kube client.Reader, list client.ObjectList, id IDFunc) MapFunc {
+	return func(ctx context.Context, o Object) []client.Object {
+		l := list.DeepCopyObject().(client.ObjectList)
+		if err := kube.List(ctx, l); err != nil {
+			return nil
+		}
+		items, err := apimeta.ExtractList(l)
+		if err != nil {
+			return nil
+		}
+		var objects []client.Object
+		for _, item := range items {
+			mg, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			rid := id(mg)
+			if rid == o.ID || (rid == "" && o.Name != "" && mg.GetName() == o.Name) {
+				objects = append(objects, mg)
+			}
+		}
+		return objects
+	}
+}
+
+// ExternalNameID is the IDFunc of managed resources whose Rancher ID is
+// recorded as their external name. Until it is, the external name defaults
+// to the name of the managed resource.
+func ExternalNameID(mg client.Object) string {
+	if name := meta.GetExternalName(mg); name != mg.GetName() {
+		return name
+	}
+	return ""
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+)
+
+func cluster(name, externalName string) *v1alpha1.RKE1Cluster {
+	cr := &v1alpha1.RKE1Cluster{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if externalName != "" {
+		meta.SetExternalName(cr, externalName)
+	}
+	return cr
+}
+
+func TestByID(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		reason   string
+		clusters []client.Object
+		o        Object
+		want     []string
+	}{
+		"ByID": {
+			reason:   "Managed resources should be matched by the Rancher ID recorded as their external name, whatever their name.",
+			clusters: []client.Object{cluster("prod", "c-123"), cluster("staging", "c-456")},
+			o:        Object{ID: "c-123", Name: "production"},
+			want:     []string{"prod"},
+		},
+		"ByNameWithoutID": {
+			reason:   "Managed resources whose ID is not recorded yet should be matched by name.",
+			clusters: []client.Object{cluster("prod", "prod"), cluster("staging", "")},
+			o:        Object{ID: "c-123", Name: "prod"},
+			want:     []string{"prod"},
+		},
+		"NotByNameWithOtherID": {
+			reason:   "Managed resources of another Rancher object should not be matched by name.",
+			clusters: []client.Object{cluster("prod", "c-456")},
+			o:        Object{ID: "c-123", Name: "prod"},
+		},
+		"NoMatch": {
+			reason:   "Rancher objects without managed resources should map to none.",
+			clusters: []client.Object{cluster("staging", "c-456")},
+			o:        Object{ID: "c-123", Name: "prod"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.clusters...).Build()
+			var got []string
+			for _, obj := range ByID(kube, &v1alpha1.RKE1ClusterList{}, ExternalNameID)(context.Background(), tc.o) {
+				got = append(got, obj.GetName())
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nByID(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
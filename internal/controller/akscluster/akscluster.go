@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akscluster
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/hosted/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/hosted"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+// Setup adds a controller that reconciles AKSCluster managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
+	return hosted.Setup(mgr, o, lists, hosted.Kind{
+		Kind:             v1alpha1.AKSClusterKind,
+		GroupKind:        v1alpha1.AKSClusterGroupKind,
+		GroupVersionKind: v1alpha1.AKSClusterGroupVersionKind,
+		Object:           func() client.Object { return &v1alpha1.AKSCluster{} },
+		List:             func() client.ObjectList { return &v1alpha1.AKSClusterList{} },
+		Cluster: func(mg resource.Managed) (hosted.Cluster, bool) {
+			cr, ok := mg.(*v1alpha1.AKSCluster)
+			return cluster{cr}, ok
+		},
+		HasConfig: func(d *v1alpha1.HostedClusterData) bool {
+			return d.AKSConfig != nil
+		},
+	})
+}
+
+// cluster adapts a AKSCluster to the hosted cluster client.
+type cluster struct {
+	*v1alpha1.AKSCluster
+}
+
+func (c cluster) Observation() *v1alpha1.HostedClusterObservation {
+	return &c.Status.AtProvider.HostedClusterObservation
+}
+
+func (c cluster) KubeconfigSecretNamespace() string {
+	return c.Spec.ForProvider.KubeconfigSecretNamespace
+}
+
+func (c cluster) CloudCredentialRef() string {
+	if c.Spec.ForProvider.AKSConfig.AzureCredentialSecret != "" {
+		return ""
+	}
+	return c.Spec.ForProvider.CloudCredentialRef
+}
+
+func (c cluster) Request(credentialID string) v1alpha1.HostedClusterRequest {
+	cfg := desiredConfig(c.AKSCluster)
+	if cfg.AzureCredentialSecret == "" {
+		cfg.AzureCredentialSecret = credentialID
+	}
+	return v1alpha1.HostedClusterRequest{
+		Name:        c.Name,
+		Description: c.Spec.ForProvider.Description,
+		Labels:      c.Spec.ForProvider.Labels,
+		AKSConfig:   cfg,
+	}
+}
+
+// UpToDate compares the desired config with the spec of the AKS cluster
+// Rancher read from Azure into aksStatus.upstreamSpec.
+func (c cluster) UpToDate(d *v1alpha1.HostedClusterData) (bool, error) {
+	if d.AKSStatus == nil || d.AKSStatus.UpstreamSpec == nil {
+		return true, nil
+	}
+	return util.IsSubset(desiredConfig(c.AKSCluster), d.AKSStatus.UpstreamSpec)
+}
+
+// desiredConfig returns the aksConfig of the supplied AKSCluster with the
+// cluster name defaulted to the name of the managed resource.
+func desiredConfig(cr *v1alpha1.AKSCluster) *v1alpha1.AKSClusterConfigSpec {
+	cfg := cr.Spec.ForProvider.AKSConfig.DeepCopy()
+	if cfg.ClusterName == "" {
+		cfg.ClusterName = cr.Name
+	}
+	return cfg
+}
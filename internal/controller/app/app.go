@@ -0,0 +1,352 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/catalog/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotApp       = "managed resource is not a App custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+	errNoCluster    = "either clusterId or clusterIdRef must be set"
+	errCompare      = "cannot compare app with Rancher"
+)
+
+const defaultTimeout = 10 * time.Minute
+
+// Setup adds a controller that reconciles App managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
+	name := managed.ControllerName(v1alpha1.AppGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AppGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			lists: lists})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+		managed.WithInitializers())
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.App{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+	lists *cache.Cache
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.App)
+	if !ok {
+		return nil, errors.New(errNotApp)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost, lists: c.lists}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+	lists       *cache.Cache
+}
+
+// listKey identifies the Rancher server and credentials of the client in the
+// list cache.
+func (c *external) listKey() cache.Key {
+	return cache.Key{Host: c.rancherHost, Token: c.token}
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.App)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApp)
+	}
+
+	// The cluster referenced by a deleted app may be gone already, so deleted
+	// apps are looked up in the cluster recorded in their status. An app is
+	// gone with its cluster.
+	clusterID := cr.Status.AtProvider.ClusterID
+	if meta.WasDeleted(cr) {
+		if clusterID == "" {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		cluster, err := util.GetCluster(c.rancherHost, c.token, clusterID, c.httpClient, ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if cluster == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+	} else {
+		id, err := c.clusterID(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		clusterID = id
+		cr.Status.AtProvider.ClusterID = clusterID
+	}
+
+	app, err := util.GetApp(c.rancherHost, c.token, clusterID, cr.Spec.ForProvider.Namespace, releaseName(cr), c.httpClient, ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if app == nil {
+		if installPending(cr) {
+			cr.Status.SetConditions(xpv1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	status := ""
+	if app.Spec.Info != nil {
+		status = app.Spec.Info.Status
+	}
+	cr.Status.AtProvider.Status = status
+	cr.Status.AtProvider.Revision = app.Spec.Version
+	cr.Status.AtProvider.Version = ""
+	if app.Spec.Chart != nil {
+		cr.Status.AtProvider.Version = app.Spec.Chart.Metadata.Version
+	}
+	if status == "deployed" {
+		cr.Status.SetConditions(xpv1.Available())
+	} else {
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	// A release with an operation in progress cannot be changed until the
+	// operation finished.
+	if strings.HasPrefix(status, "pending-") {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	upToDate, err := releaseUpToDate(cr, app)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.App)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApp)
+	}
+
+	clusterID, err := c.clusterID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	p := cr.Spec.ForProvider
+	input := v1alpha1.ChartInstallAction{
+		Namespace: p.Namespace,
+		Wait:      p.Wait,
+		Timeout:   timeout(cr),
+		Charts: []v1alpha1.ChartInstall{{
+			ChartName:   p.Chart,
+			Version:     p.Version,
+			ReleaseName: releaseName(cr),
+			Values:      values(cr),
+		}},
+	}
+	if err := util.InstallChart(c.rancherHost, c.token, clusterID, p.RepoName, input, c.httpClient, ctx); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, releaseName(cr))
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.App)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotApp)
+	}
+
+	p := cr.Spec.ForProvider
+	input := v1alpha1.ChartUpgradeAction{
+		Namespace: p.Namespace,
+		Wait:      p.Wait,
+		Timeout:   timeout(cr),
+		Charts: []v1alpha1.ChartUpgrade{{
+			ChartName:   p.Chart,
+			Version:     p.Version,
+			ReleaseName: releaseName(cr),
+			Values:      values(cr),
+			ResetValues: true,
+		}},
+	}
+	if err := util.UpgradeChart(c.rancherHost, c.token, cr.Status.AtProvider.ClusterID, p.RepoName, input, c.httpClient, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.App)
+	if !ok {
+		return errors.New(errNotApp)
+	}
+	if cr.Status.AtProvider.Status == "uninstalling" {
+		return nil
+	}
+	input := v1alpha1.ChartUninstallAction{Timeout: timeout(cr)}
+	return util.UninstallApp(c.rancherHost, c.token, cr.Status.AtProvider.ClusterID, cr.Spec.ForProvider.Namespace, releaseName(cr), input, c.httpClient, ctx)
+}
+
+// releaseUpToDate reports whether the release runs the desired chart version with
+// exactly the desired values.
+func releaseUpToDate(cr *v1alpha1.App, app *v1alpha1.AppData) (bool, error) {
+	p := cr.Spec.ForProvider
+	if app.Spec.Chart == nil || app.Spec.Chart.Metadata.Name != p.Chart || app.Spec.Chart.Metadata.Version != p.Version {
+		return false, nil
+	}
+	desired := values(cr)
+	observed := app.Spec.Values
+	if observed == nil {
+		observed = json.RawMessage("{}")
+	}
+	if desired == nil {
+		desired = json.RawMessage("{}")
+	}
+	added, err := util.IsSubset(desired, observed)
+	if err != nil || !added {
+		return false, err
+	}
+	return util.IsSubset(observed, desired)
+}
+
+// clusterID returns the ID of the cluster the chart is installed into,
+// resolving clusterIdRef if necessary.
+func (c *external) clusterID(ctx context.Context, cr *v1alpha1.App) (string, error) {
+	p := cr.Spec.ForProvider
+	if p.ClusterID != "" {
+		return p.ClusterID, nil
+	}
+	if p.ClusterIDRef == "" {
+		return "", errors.New(errNoCluster)
+	}
+	return c.lists.ClusterIDByName(ctx, c.listKey(), c.httpClient, p.ClusterIDRef)
+}
+
+// installPending reports whether the chart was installed but its release has not
+// appeared yet. Installations run asynchronously in the cluster and the
+// release only becomes visible once the installation started, so the release
+// is given until the timeout of the installation to appear before the chart is
+// installed again.
+func installPending(cr *v1alpha1.App) bool {
+	return meta.GetExternalName(cr) == releaseName(cr) && meta.ExternalCreateSucceededDuring(cr, timeout(cr).Duration)
+}
+
+func releaseName(cr *v1alpha1.App) string {
+	if cr.Spec.ForProvider.ReleaseName != "" {
+		return cr.Spec.ForProvider.ReleaseName
+	}
+	return cr.Name
+}
+
+func values(cr *v1alpha1.App) json.RawMessage {
+	if v := cr.Spec.ForProvider.Values; v != nil && len(v.Raw) > 0 {
+		return json.RawMessage(v.Raw)
+	}
+	return nil
+}
+
+func timeout(cr *v1alpha1.App) *metav1.Duration {
+	if cr.Spec.ForProvider.Timeout != nil {
+		return cr.Spec.ForProvider.Timeout
+	}
+	return &metav1.Duration{Duration: defaultTimeout}
+}
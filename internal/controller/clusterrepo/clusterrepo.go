@@ -0,0 +1,254 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterrepo
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/catalog/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotClusterRepo = "managed resource is not a ClusterRepo custom resource"
+	errTrackPCUsage   = "cannot track ProviderConfig usage"
+	errGetPC          = "cannot get ProviderConfig"
+	errGetCreds       = "cannot get credentials"
+	errNoSource       = "either url or gitRepo must be set"
+	errCompare        = "cannot compare cluster repo with Rancher"
+)
+
+// Setup adds a controller that reconciles ClusterRepo managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
+	name := managed.ControllerName(v1alpha1.ClusterRepoGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ClusterRepoGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			lists: lists})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ClusterRepo{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+	lists *cache.Cache
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ClusterRepo)
+	if !ok {
+		return nil, errors.New(errNotClusterRepo)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost, lists: c.lists}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+	lists       *cache.Cache
+}
+
+// listKey identifies the Rancher server and credentials of the client in the
+// list cache.
+func (c *external) listKey() cache.Key {
+	return cache.Key{Host: c.rancherHost, Token: c.token}
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterRepo)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClusterRepo)
+	}
+
+	clusterID, err := c.clusterID(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.ClusterID = clusterID
+
+	repo, err := util.GetClusterRepo(c.rancherHost, c.token, clusterID, cr.Name, c.httpClient, ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if repo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Unavailable())
+	if s := repo.Status; s != nil {
+		cr.Status.AtProvider.Commit = s.Commit
+		cr.Status.AtProvider.DownloadTime = s.DownloadTime
+		for _, cond := range s.Conditions {
+			if cond.Type == "Downloaded" && cond.Status == "True" {
+				cr.Status.SetConditions(xpv1.Available())
+			}
+		}
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	upToDate, err := util.IsSubset(desiredSpec(cr), repo.Spec)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterRepo)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClusterRepo)
+	}
+
+	if cr.Spec.ForProvider.URL == "" && cr.Spec.ForProvider.GitRepo == "" {
+		return managed.ExternalCreation{}, errors.New(errNoSource)
+	}
+	clusterID, err := c.clusterID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := util.CreateClusterRepo(c.rancherHost, c.token, clusterID, cr.Name, desiredSpec(cr), c.httpClient, ctx); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ClusterRepo)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotClusterRepo)
+	}
+
+	clusterID := cr.Status.AtProvider.ClusterID
+	repo, err := util.GetClusterRepo(c.rancherHost, c.token, clusterID, cr.Name, c.httpClient, ctx)
+	if err != nil || repo == nil {
+		return managed.ExternalUpdate{}, err
+	}
+	repo.Spec = desiredSpec(cr)
+	if err := util.UpdateClusterRepo(c.rancherHost, c.token, clusterID, *repo, c.httpClient, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClusterRepo)
+	if !ok {
+		return errors.New(errNotClusterRepo)
+	}
+	return util.DeleteClusterRepo(c.rancherHost, c.token, cr.Status.AtProvider.ClusterID, cr.Name, c.httpClient, ctx)
+}
+
+// clusterID returns the ID of the cluster the repository is added to,
+// resolving clusterIdRef if necessary.
+func (c *external) clusterID(ctx context.Context, cr *v1alpha1.ClusterRepo) (string, error) {
+	p := cr.Spec.ForProvider
+	if p.ClusterID != "" {
+		return p.ClusterID, nil
+	}
+	if p.ClusterIDRef == "" {
+		return "local", nil
+	}
+	return c.lists.ClusterIDByName(ctx, c.listKey(), c.httpClient, p.ClusterIDRef)
+}
+
+func desiredSpec(cr *v1alpha1.ClusterRepo) v1alpha1.ClusterRepoSpecData {
+	p := cr.Spec.ForProvider
+	spec := v1alpha1.ClusterRepoSpecData{
+		URL:                   p.URL,
+		GitRepo:               p.GitRepo,
+		GitBranch:             p.GitBranch,
+		ClientSecret:          p.ClientSecret,
+		InsecureSkipTLSVerify: p.InsecureSkipTLSVerify,
+	}
+	if p.CABundle != "" {
+		spec.CABundle = []byte(p.CABundle)
+	}
+	return spec
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterroletemplatebinding
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotClusterRoleTemplateBinding = "managed resource is not a ClusterRoleTemplateBinding custom resource"
+	errTrackPCUsage                  = "cannot track ProviderConfig usage"
+	errGetPC                         = "cannot get ProviderConfig"
+	errGetCreds                      = "cannot get credentials"
+	errNoCluster                     = "either clusterId or clusterIdRef must be set"
+	errNoRoleTemplate                = "either roleTemplateId or roleTemplateIdRef must be set"
+	errGetRoleTemplate               = "cannot get RoleTemplate referenced by roleTemplateIdRef"
+	errRoleTemplateNotReady          = "RoleTemplate referenced by roleTemplateIdRef has not been created yet"
+	errCompare                       = "cannot compare binding with Rancher"
+)
+
+// Setup adds a controller that reconciles ClusterRoleTemplateBinding managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
+	name := managed.ControllerName(v1alpha1.ClusterRoleTemplateBindingGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ClusterRoleTemplateBindingGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			lists: lists})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ClusterRoleTemplateBinding{}).
+		Watches(lists.Subscribe(cache.ClusterRoleTemplateBindings, cache.ByID(mgr.GetClient(), &v1alpha1.ClusterRoleTemplateBindingList{}, bindingID)),
+			&handler.EnqueueRequestForObject{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+	lists *cache.Cache
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ClusterRoleTemplateBinding)
+	if !ok {
+		return nil, errors.New(errNotClusterRoleTemplateBinding)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost, lists: c.lists}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+	lists       *cache.Cache
+}
+
+// listKey identifies the Rancher server and credentials of the client in the
+// list cache.
+func (c *external) listKey() cache.Key {
+	return cache.Key{Host: c.rancherHost, Token: c.token}
+}
+
+// bindingID returns the Rancher ID of the binding of a ClusterRoleTemplateBinding, which is
+// recorded in its status once observed.
+func bindingID(mg client.Object) string {
+	cr, ok := mg.(*v1alpha1.ClusterRoleTemplateBinding)
+	if !ok {
+		return ""
+	}
+	return cr.Status.AtProvider.ID
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterRoleTemplateBinding)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClusterRoleTemplateBinding)
+	}
+
+	// Bindings may be removed out-of-band, in which case looking them up by
+	// ID reports that they no longer exist and they are created again.
+	var binding *v1alpha1.RoleBindingData
+	if id := cr.Status.AtProvider.ID; id != "" {
+		b, err := util.GetRoleBinding(c.rancherHost, c.token, util.ClusterRoleTemplateBindings, id, c.httpClient, ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		binding = b
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: binding != nil}, nil
+	}
+
+	desired, err := c.desiredBinding(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if cr.Status.AtProvider.ID == "" {
+		binding, err = c.lists.FindRoleBinding(ctx, c.listKey(), cache.ClusterRoleTemplateBindings, c.httpClient, desired)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	if binding == nil {
+		cr.Status.AtProvider.ID = ""
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.ID = binding.ID
+	cr.Status.AtProvider.UserID = binding.UserID
+	cr.Status.AtProvider.GroupPrincipalID = binding.GroupPrincipalID
+	cr.Status.SetConditions(xpv1.Available())
+
+	upToDate, err := util.IsSubset(desired, binding)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterRoleTemplateBinding)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClusterRoleTemplateBinding)
+	}
+
+	desired, err := c.desiredBinding(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	id, err := util.CreateRoleBinding(c.rancherHost, c.token, util.ClusterRoleTemplateBindings, c.httpClient, desired, ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	c.lists.Invalidate(c.listKey(), cache.ClusterRoleTemplateBindings)
+	cr.Status.AtProvider.ID = id
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update replaces the binding, since Rancher does not allow changing the role
+// or subject of an existing binding.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ClusterRoleTemplateBinding)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotClusterRoleTemplateBinding)
+	}
+
+	desired, err := c.desiredBinding(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := util.DeleteRoleBinding(c.rancherHost, c.token, util.ClusterRoleTemplateBindings, cr.Status.AtProvider.ID, c.httpClient, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	id, err := util.CreateRoleBinding(c.rancherHost, c.token, util.ClusterRoleTemplateBindings, c.httpClient, desired, ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	c.lists.Invalidate(c.listKey(), cache.ClusterRoleTemplateBindings)
+	cr.Status.AtProvider.ID = id
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClusterRoleTemplateBinding)
+	if !ok {
+		return errors.New(errNotClusterRoleTemplateBinding)
+	}
+	if cr.Status.AtProvider.ID == "" {
+		return nil
+	}
+	if err := util.DeleteRoleBinding(c.rancherHost, c.token, util.ClusterRoleTemplateBindings, cr.Status.AtProvider.ID, c.httpClient, ctx); err != nil {
+		return err
+	}
+	c.lists.Invalidate(c.listKey(), cache.ClusterRoleTemplateBindings)
+	return nil
+}
+
+// desiredBinding returns the binding described by the supplied managed
+// resource, resolving clusterIdRef and the subject if necessary.
+func (c *external) desiredBinding(ctx context.Context, cr *v1alpha1.ClusterRoleTemplateBinding) (v1alpha1.RoleBindingData, error) {
+	p := cr.Spec.ForProvider
+	binding, err := util.ResolveSubject(c.rancherHost, c.token, p.Subject, c.httpClient, ctx)
+	if err != nil {
+		return binding, err
+	}
+	binding.RoleTemplateID = p.RoleTemplateID
+	if binding.RoleTemplateID == "" {
+		binding.RoleTemplateID, err = c.referencedRoleTemplateID(ctx, p.RoleTemplateIDRef)
+		if err != nil {
+			return binding, err
+		}
+	}
+	binding.ClusterID = p.ClusterID
+	if binding.ClusterID == "" {
+		if p.ClusterIDRef == "" {
+			return binding, errors.New(errNoCluster)
+		}
+		binding.ClusterID, err = c.lists.ClusterIDByName(ctx, c.listKey(), c.httpClient, p.ClusterIDRef)
+		if err != nil {
+			return binding, err
+		}
+	}
+	cr.Status.AtProvider.ClusterID = binding.ClusterID
+	return binding, nil
+}
+
+// referencedRoleTemplateID returns the Rancher ID of the RoleTemplate managed
+// resource with the supplied name.
+func (c *external) referencedRoleTemplateID(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		return "", errors.New(errNoRoleTemplate)
+	}
+	rt := &v1alpha1.RoleTemplate{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: name}, rt); err != nil {
+		return "", errors.Wrap(err, errGetRoleTemplate)
+	}
+	if rt.Status.AtProvider.ID == "" {
+		return "", errors.New(errRoleTemplateNotReady)
+	}
+	return rt.Status.AtProvider.ID, nil
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplate
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotClusterTemplate = "managed resource is not a ClusterTemplate custom resource"
+	errTrackPCUsage       = "cannot track ProviderConfig usage"
+	errGetPC              = "cannot get ProviderConfig"
+	errGetCreds           = "cannot get credentials"
+	errCompare            = "cannot compare cluster template with Rancher"
+	errGetRevision        = "cannot get ClusterTemplateRevision referenced by defaultRevisionIdRef"
+)
+
+// Setup adds a controller that reconciles ClusterTemplate managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ClusterTemplateGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ClusterTemplateGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{})})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ClusterTemplate{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ClusterTemplate)
+	if !ok {
+		return nil, errors.New(errNotClusterTemplate)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClusterTemplate)
+	}
+
+	var observed *v1alpha1.ClusterTemplateData
+	var err error
+	if id := cr.Status.AtProvider.ID; id != "" {
+		observed, err = util.GetClusterTemplate(c.rancherHost, c.token, id, c.httpClient, ctx)
+	} else {
+		observed, err = util.GetClusterTemplateByName(c.rancherHost, c.token, cr.Name, c.httpClient, ctx)
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if observed == nil {
+		cr.Status.AtProvider.ID = ""
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.ID = observed.ID
+	cr.Status.AtProvider.DefaultRevisionID = observed.DefaultRevisionID
+	cr.Status.SetConditions(xpv1.Available())
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	desired, err := c.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	upToDate, err := util.IsSubset(desired, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClusterTemplate)
+	}
+
+	desired, err := c.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	id, err := util.CreateClusterTemplate(c.rancherHost, c.token, c.httpClient, desired, ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cr.Status.AtProvider.ID = id
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ClusterTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotClusterTemplate)
+	}
+
+	desired, err := c.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := util.UpdateClusterTemplate(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, desired, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClusterTemplate)
+	if !ok {
+		return errors.New(errNotClusterTemplate)
+	}
+	return util.DeleteClusterTemplate(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+}
+
+func (c *external) desired(ctx context.Context, cr *v1alpha1.ClusterTemplate) (v1alpha1.ClusterTemplateData, error) {
+	p := cr.Spec.ForProvider
+	ct := v1alpha1.ClusterTemplateData{
+		Name:              cr.Name,
+		Description:       p.Description,
+		Members:           p.Members,
+		DefaultRevisionID: p.DefaultRevisionID,
+	}
+	if ct.DefaultRevisionID == "" && p.DefaultRevisionIDRef != "" {
+		id, err := c.referencedRevisionID(ctx, p.DefaultRevisionIDRef)
+		if err != nil {
+			return ct, err
+		}
+		ct.DefaultRevisionID = id
+	}
+	return ct, nil
+}
+
+// referencedRevisionID returns the Rancher ID of the ClusterTemplateRevision
+// managed resource with the supplied name. Revisions need their template to
+// exist first, so a revision that does not exist yet resolves to an empty ID
+// and the default revision is set once it has been created.
+func (c *external) referencedRevisionID(ctx context.Context, name string) (string, error) {
+	rev := &v1alpha1.ClusterTemplateRevision{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: name}, rev); err != nil {
+		if kerrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", errors.Wrap(err, errGetRevision)
+	}
+	return rev.Status.AtProvider.ID, nil
+}
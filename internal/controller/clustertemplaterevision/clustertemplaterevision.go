@@ -0,0 +1,271 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplaterevision
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotClusterTemplateRevision = "managed resource is not a ClusterTemplateRevision custom resource"
+	errTrackPCUsage               = "cannot track ProviderConfig usage"
+	errGetPC                      = "cannot get ProviderConfig"
+	errGetCreds                   = "cannot get credentials"
+	errNoTemplate                 = "either clusterTemplateId or clusterTemplateIdRef must be set"
+	errGetTemplate                = "cannot get ClusterTemplate referenced by clusterTemplateIdRef"
+	errTemplateNotReady           = "ClusterTemplate referenced by clusterTemplateIdRef has not been created yet"
+	errCompare                    = "cannot compare cluster template revision with Rancher"
+	errImmutable                  = "cluster config and questions of a cluster template revision are immutable"
+)
+
+// Setup adds a controller that reconciles ClusterTemplateRevision managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ClusterTemplateRevisionGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ClusterTemplateRevisionGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{})})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ClusterTemplateRevision{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ClusterTemplateRevision)
+	if !ok {
+		return nil, errors.New(errNotClusterTemplateRevision)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterTemplateRevision)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotClusterTemplateRevision)
+	}
+
+	var observed *v1alpha1.ClusterTemplateRevisionData
+	var err error
+	if id := cr.Status.AtProvider.ID; id != "" {
+		observed, err = util.GetClusterTemplateRevision(c.rancherHost, c.token, id, c.httpClient, ctx)
+	} else {
+		var templateID string
+		if templateID, err = c.templateID(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		observed, err = util.GetClusterTemplateRevisionByName(c.rancherHost, c.token, templateID, cr.Name, c.httpClient, ctx)
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if observed == nil {
+		cr.Status.AtProvider.ID = ""
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.ID = observed.ID
+	cr.Status.AtProvider.ClusterTemplateID = observed.ClusterTemplateID
+	cr.Status.SetConditions(xpv1.Available())
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	want := desired(cr, observed.ClusterTemplateID)
+	util.ClearRKECredentials(&want.ClusterConfig.RKEClusterSpec)
+	upToDate, err := util.IsSubset(want, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterTemplateRevision)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotClusterTemplateRevision)
+	}
+
+	templateID, err := c.templateID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	want := desired(cr, templateID)
+	if err := util.ResolveRKECredentials(ctx, c.kube, &want.ClusterConfig.RKEClusterSpec); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	id, err := util.CreateClusterTemplateRevision(c.rancherHost, c.token, c.httpClient, want, ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cr.Status.AtProvider.ID = id
+	cr.Status.AtProvider.ClusterTemplateID = templateID
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update enables or disables the revision. Any other change is refused as
+// Rancher does not allow changing revisions.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ClusterTemplateRevision)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotClusterTemplateRevision)
+	}
+
+	id := cr.Status.AtProvider.ID
+	observed, err := util.GetClusterTemplateRevision(c.rancherHost, c.token, id, c.httpClient, ctx)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+	want := desired(cr, observed.ClusterTemplateID)
+	util.ClearRKECredentials(&want.ClusterConfig.RKEClusterSpec)
+	immutable := v1alpha1.ClusterTemplateRevisionData{ClusterConfig: want.ClusterConfig, Questions: want.Questions}
+	unchanged, err := util.IsSubset(immutable, observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCompare)
+	}
+	if !unchanged {
+		return managed.ExternalUpdate{}, errors.New(errImmutable)
+	}
+
+	observed.Enabled = want.Enabled
+	if err := util.UpdateClusterTemplateRevision(c.rancherHost, c.token, id, c.httpClient, *observed, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClusterTemplateRevision)
+	if !ok {
+		return errors.New(errNotClusterTemplateRevision)
+	}
+	return util.DeleteClusterTemplateRevision(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+}
+
+// templateID returns the ID of the cluster template of the revision,
+// resolving clusterTemplateIdRef if necessary.
+func (c *external) templateID(ctx context.Context, cr *v1alpha1.ClusterTemplateRevision) (string, error) {
+	p := cr.Spec.ForProvider
+	if p.ClusterTemplateID != "" {
+		return p.ClusterTemplateID, nil
+	}
+	if p.ClusterTemplateIDRef == "" {
+		return "", errors.New(errNoTemplate)
+	}
+	ct := &v1alpha1.ClusterTemplate{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: p.ClusterTemplateIDRef}, ct); err != nil {
+		return "", errors.Wrap(err, errGetTemplate)
+	}
+	if ct.Status.AtProvider.ID == "" {
+		return "", errors.New(errTemplateNotReady)
+	}
+	return ct.Status.AtProvider.ID, nil
+}
+
+func desired(cr *v1alpha1.ClusterTemplateRevision, templateID string) v1alpha1.ClusterTemplateRevisionData {
+	p := cr.Spec.ForProvider
+	enabled := true
+	if p.Enabled != nil {
+		enabled = *p.Enabled
+	}
+	return v1alpha1.ClusterTemplateRevisionData{
+		Name:              cr.Name,
+		ClusterTemplateID: templateID,
+		Enabled:           &enabled,
+		ClusterConfig:     p.ClusterConfig.DeepCopy(),
+		Questions:         p.Questions,
+	}
+}
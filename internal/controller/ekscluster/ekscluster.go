@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ekscluster
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/hosted/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/hosted"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+// Setup adds a controller that reconciles EKSCluster managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
+	return hosted.Setup(mgr, o, lists, hosted.Kind{
+		Kind:             v1alpha1.EKSClusterKind,
+		GroupKind:        v1alpha1.EKSClusterGroupKind,
+		GroupVersionKind: v1alpha1.EKSClusterGroupVersionKind,
+		Object:           func() client.Object { return &v1alpha1.EKSCluster{} },
+		List:             func() client.ObjectList { return &v1alpha1.EKSClusterList{} },
+		Cluster: func(mg resource.Managed) (hosted.Cluster, bool) {
+			cr, ok := mg.(*v1alpha1.EKSCluster)
+			return cluster{cr}, ok
+		},
+		HasConfig: func(d *v1alpha1.HostedClusterData) bool {
+			return d.EKSConfig != nil
+		},
+	})
+}
+
+// cluster adapts a EKSCluster to the hosted cluster client.
+type cluster struct {
+	*v1alpha1.EKSCluster
+}
+
+func (c cluster) Observation() *v1alpha1.HostedClusterObservation {
+	return &c.Status.AtProvider.HostedClusterObservation
+}
+
+func (c cluster) KubeconfigSecretNamespace() string {
+	return c.Spec.ForProvider.KubeconfigSecretNamespace
+}
+
+func (c cluster) CloudCredentialRef() string {
+	if c.Spec.ForProvider.EKSConfig.AmazonCredentialSecret != "" {
+		return ""
+	}
+	return c.Spec.ForProvider.CloudCredentialRef
+}
+
+func (c cluster) Request(credentialID string) v1alpha1.HostedClusterRequest {
+	cfg := desiredConfig(c.EKSCluster)
+	if cfg.AmazonCredentialSecret == "" {
+		cfg.AmazonCredentialSecret = credentialID
+	}
+	return v1alpha1.HostedClusterRequest{
+		Name:        c.Name,
+		Description: c.Spec.ForProvider.Description,
+		Labels:      c.Spec.ForProvider.Labels,
+		EKSConfig:   cfg,
+	}
+}
+
+// UpToDate compares the desired config with the spec of the EKS cluster
+// Rancher read from AWS into eksStatus.upstreamSpec.
+func (c cluster) UpToDate(d *v1alpha1.HostedClusterData) (bool, error) {
+	if d.EKSStatus == nil || d.EKSStatus.UpstreamSpec == nil {
+		return true, nil
+	}
+	return util.IsSubset(desiredConfig(c.EKSCluster), d.EKSStatus.UpstreamSpec)
+}
+
+// desiredConfig returns the eksConfig of the supplied EKSCluster with the
+// display name defaulted to the name of the managed resource.
+func desiredConfig(cr *v1alpha1.EKSCluster) *v1alpha1.EKSClusterConfigSpec {
+	cfg := cr.Spec.ForProvider.EKSConfig.DeepCopy()
+	if cfg.DisplayName == "" {
+		cfg.DisplayName = cr.Name
+	}
+	return cfg
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdsnapshotrestore
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotEtcdSnapshotRestore = "managed resource is not a EtcdSnapshotRestore custom resource"
+	errTrackPCUsage           = "cannot track ProviderConfig usage"
+	errGetPC                  = "cannot get ProviderConfig"
+	errGetCreds               = "cannot get credentials"
+	errNoCluster              = "either clusterId or clusterIdRef must be set"
+	errNoBackup               = "either etcdBackupId or etcdSnapshotRef must be set"
+	errGetSnapshot            = "cannot get EtcdSnapshot referenced by etcdSnapshotRef"
+	errSnapshotNotReady       = "EtcdSnapshot referenced by etcdSnapshotRef has not been created yet"
+	// restoreSettle is how long a cluster must have been restoring before an
+	// active cluster is taken as the restore having completed, since Rancher
+	// only moves the cluster out of active some time after the request.
+	restoreSettle = time.Minute
+)
+
+// Setup adds a controller that reconciles EtcdSnapshotRestore managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
+	name := managed.ControllerName(v1alpha1.EtcdSnapshotRestoreGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.EtcdSnapshotRestoreGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			lists: lists})),
+		// The external name is the ID of the restored etcd backup.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.EtcdSnapshotRestore{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+	lists *cache.Cache
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.EtcdSnapshotRestore)
+	if !ok {
+		return nil, errors.New(errNotEtcdSnapshotRestore)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost, lists: c.lists}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+	lists       *cache.Cache
+}
+
+// listKey identifies the Rancher server and credentials of the client in the
+// list cache.
+func (c *external) listKey() cache.Key {
+	return cache.Key{Host: c.rancherHost, Token: c.token}
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.EtcdSnapshotRestore)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEtcdSnapshotRestore)
+	}
+
+	// The restore exists once it has been requested; deleting it does not
+	// affect the cluster. Create records the backup it restored as the
+	// external name, the only field persisted after Create.
+	restored := meta.GetExternalName(cr)
+	if restored == "" || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if cr.Status.AtProvider.EtcdBackupID == "" {
+		if err := c.recordRestore(ctx, cr, restored); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	obs := cr.Status.AtProvider
+
+	cluster, err := util.GetCluster(c.rancherHost, c.token, obs.ClusterID, c.httpClient, ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if cluster == nil {
+		cr.Status.AtProvider.ClusterState = ""
+		cr.Status.SetConditions(xpv1.Unavailable(), v1alpha1.RestoreFailed("cluster "+obs.ClusterID+" does not exist"))
+	} else {
+		cr.Status.AtProvider.ClusterState = cluster.State
+		settled := obs.StartedAt == nil || time.Since(obs.StartedAt.Time) > restoreSettle
+		switch {
+		case cluster.Transitioning == "error":
+			cr.Status.SetConditions(xpv1.Unavailable(), v1alpha1.RestoreFailed(cluster.TransitioningMessage))
+		case cluster.State == "active" && settled:
+			cr.Status.SetConditions(xpv1.Available(), v1alpha1.RestoreComplete())
+		default:
+			cr.Status.SetConditions(xpv1.Unavailable(), v1alpha1.Restoring())
+		}
+	}
+
+	backupID, err := c.backupID(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  backupID == obs.EtcdBackupID && cr.Spec.ForProvider.RestoreRKEConfig == obs.RestoreRKEConfig,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.EtcdSnapshotRestore)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEtcdSnapshotRestore)
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, c.restore(ctx, cr)
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.EtcdSnapshotRestore)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEtcdSnapshotRestore)
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, c.restore(ctx, cr)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	return nil
+}
+
+// restore requests the restore of the cluster from the selected etcd backup.
+func (c *external) restore(ctx context.Context, cr *v1alpha1.EtcdSnapshotRestore) error {
+	backupID, err := c.backupID(ctx, cr)
+	if err != nil {
+		return err
+	}
+	clusterID, err := c.clusterID(ctx, cr, backupID)
+	if err != nil {
+		return err
+	}
+	input := v1alpha1.RestoreFromEtcdBackupInput{
+		EtcdBackupID:     backupID,
+		RestoreRKEConfig: cr.Spec.ForProvider.RestoreRKEConfig,
+	}
+	if err := util.RestoreFromEtcdBackup(c.rancherHost, c.token, clusterID, input, c.httpClient, ctx); err != nil {
+		return err
+	}
+	meta.SetExternalName(cr, backupID)
+	now := metav1.Now()
+	cr.Status.AtProvider.ClusterID = clusterID
+	cr.Status.AtProvider.EtcdBackupID = backupID
+	cr.Status.AtProvider.RestoreRKEConfig = input.RestoreRKEConfig
+	cr.Status.AtProvider.StartedAt = &now
+	cr.Status.SetConditions(xpv1.Unavailable(), v1alpha1.Restoring())
+	return nil
+}
+
+// recordRestore records the restore requested by Create in the status, which
+// is not persisted after Create.
+func (c *external) recordRestore(ctx context.Context, cr *v1alpha1.EtcdSnapshotRestore, backupID string) error {
+	clusterID, err := c.clusterID(ctx, cr, backupID)
+	if err != nil {
+		return err
+	}
+	started := metav1.NewTime(meta.GetExternalCreateSucceeded(cr))
+	if started.IsZero() {
+		started = metav1.Now()
+	}
+	cr.Status.AtProvider.ClusterID = clusterID
+	cr.Status.AtProvider.EtcdBackupID = backupID
+	cr.Status.AtProvider.RestoreRKEConfig = cr.Spec.ForProvider.RestoreRKEConfig
+	cr.Status.AtProvider.StartedAt = &started
+	return nil
+}
+
+// backupID returns the ID of the etcd backup to restore, resolving
+// etcdSnapshotRef if necessary.
+func (c *external) backupID(ctx context.Context, cr *v1alpha1.EtcdSnapshotRestore) (string, error) {
+	p := cr.Spec.ForProvider
+	if p.EtcdBackupID != "" {
+		return p.EtcdBackupID, nil
+	}
+	if p.EtcdSnapshotRef == "" {
+		return "", errors.New(errNoBackup)
+	}
+	snapshot := &v1alpha1.EtcdSnapshot{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: p.EtcdSnapshotRef}, snapshot); err != nil {
+		return "", errors.Wrap(err, errGetSnapshot)
+	}
+	if snapshot.Status.AtProvider.ID == "" {
+		return "", errors.New(errSnapshotNotReady)
+	}
+	return snapshot.Status.AtProvider.ID, nil
+}
+
+// clusterID returns the ID of the cluster to restore, resolving clusterIdRef
+// if necessary. Without either the cluster is taken from the backup ID, which
+// has the form <cluster ID>:<backup name>.
+func (c *external) clusterID(ctx context.Context, cr *v1alpha1.EtcdSnapshotRestore, backupID string) (string, error) {
+	p := cr.Spec.ForProvider
+	if p.ClusterID != "" {
+		return p.ClusterID, nil
+	}
+	if p.ClusterIDRef != "" {
+		return c.lists.ClusterIDByName(ctx, c.listKey(), c.httpClient, p.ClusterIDRef)
+	}
+	if i := strings.Index(backupID, ":"); i > 0 {
+		return backupID[:i], nil
+	}
+	return "", errors.New(errNoCluster)
+}
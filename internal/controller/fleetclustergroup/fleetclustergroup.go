@@ -0,0 +1,217 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleetclustergroup
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/fleet/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotFleetClusterGroup = "managed resource is not a FleetClusterGroup custom resource"
+	errTrackPCUsage         = "cannot track ProviderConfig usage"
+	errGetPC                = "cannot get ProviderConfig"
+	errGetCreds             = "cannot get credentials"
+	errCompare              = "cannot compare cluster group with Rancher"
+)
+
+const defaultWorkspace = "fleet-default"
+
+// Setup adds a controller that reconciles FleetClusterGroup managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.FleetClusterGroupGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.FleetClusterGroupGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{})})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.FleetClusterGroup{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.FleetClusterGroup)
+	if !ok {
+		return nil, errors.New(errNotFleetClusterGroup)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FleetClusterGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFleetClusterGroup)
+	}
+
+	group := &v1alpha1.ClusterGroupData{}
+	exists, err := util.GetFleetObject(c.rancherHost, c.token, util.FleetClusterGroups, workspace(cr), cr.Name, group, c.httpClient, ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if s := group.Status; s != nil {
+		cr.Status.AtProvider.ClusterCount = s.ClusterCount
+		cr.Status.AtProvider.NonReadyClusterCount = s.NonReadyClusterCount
+		cr.Status.AtProvider.Summary = s.Summary
+	}
+	cr.Status.SetConditions(xpv1.Available())
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	upToDate, err := util.IsSubset(desiredSpec(cr), group.Spec)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FleetClusterGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFleetClusterGroup)
+	}
+
+	group := v1alpha1.ClusterGroupData{
+		Type:     "fleet.cattle.io.clustergroup",
+		Metadata: v1alpha1.SteveMetadata{Name: cr.Name, Namespace: workspace(cr)},
+		Spec:     desiredSpec(cr),
+	}
+	if err := util.CreateFleetObject(c.rancherHost, c.token, util.FleetClusterGroups, group, c.httpClient, ctx); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FleetClusterGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFleetClusterGroup)
+	}
+
+	group := &v1alpha1.ClusterGroupData{}
+	if _, err := util.GetFleetObject(c.rancherHost, c.token, util.FleetClusterGroups, workspace(cr), cr.Name, group, c.httpClient, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	group.Spec = desiredSpec(cr)
+	group.Status = nil
+	if err := util.UpdateFleetObject(c.rancherHost, c.token, util.FleetClusterGroups, workspace(cr), cr.Name, group, c.httpClient, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FleetClusterGroup)
+	if !ok {
+		return errors.New(errNotFleetClusterGroup)
+	}
+	return util.DeleteFleetObject(c.rancherHost, c.token, util.FleetClusterGroups, workspace(cr), cr.Name, c.httpClient, ctx)
+}
+
+func workspace(cr *v1alpha1.FleetClusterGroup) string {
+	if cr.Spec.ForProvider.Namespace != "" {
+		return cr.Spec.ForProvider.Namespace
+	}
+	return defaultWorkspace
+}
+
+func desiredSpec(cr *v1alpha1.FleetClusterGroup) v1alpha1.ClusterGroupSpecData {
+	return v1alpha1.ClusterGroupSpecData{Selector: cr.Spec.ForProvider.Selector}
+}
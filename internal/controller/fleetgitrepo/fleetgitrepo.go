@@ -0,0 +1,304 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleetgitrepo
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/fleet/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotFleetGitRepo = "managed resource is not a FleetGitRepo custom resource"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errGetPC           = "cannot get ProviderConfig"
+	errGetCreds        = "cannot get credentials"
+	errCompare         = "cannot compare git repo with Rancher"
+	errGetSecret       = "cannot get referenced secret"
+)
+
+const defaultWorkspace = "fleet-default"
+
+// Setup adds a controller that reconciles FleetGitRepo managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.FleetGitRepoGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.FleetGitRepoGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{})})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.FleetGitRepo{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.FleetGitRepo)
+	if !ok {
+		return nil, errors.New(errNotFleetGitRepo)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FleetGitRepo)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFleetGitRepo)
+	}
+
+	repo := &v1alpha1.GitRepoData{}
+	exists, err := util.GetFleetObject(c.rancherHost, c.token, util.FleetGitRepos, workspace(cr), cr.Name, repo, c.httpClient, ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Unavailable())
+	if s := repo.Status; s != nil {
+		cr.Status.AtProvider.Commit = s.Commit
+		cr.Status.AtProvider.ReadyClusters = s.ReadyClusters
+		cr.Status.AtProvider.DesiredReadyClusters = s.DesiredReadyClusters
+		cr.Status.AtProvider.Summary = s.Summary
+		if s.ReadyClusters == s.DesiredReadyClusters && s.Summary.Ready == s.Summary.DesiredReady {
+			cr.Status.SetConditions(xpv1.Available())
+		}
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	_, _, version, err := c.sourceSecrets(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	upToDate, err := util.IsSubset(desiredSpec(cr), repo.Spec)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate && version == cr.Status.AtProvider.SecretVersion,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FleetGitRepo)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFleetGitRepo)
+	}
+
+	if err := c.copySecrets(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	repo := v1alpha1.GitRepoData{
+		Type:     "fleet.cattle.io.gitrepo",
+		Metadata: v1alpha1.SteveMetadata{Name: cr.Name, Namespace: workspace(cr)},
+		Spec:     desiredSpec(cr),
+	}
+	if err := util.CreateFleetObject(c.rancherHost, c.token, util.FleetGitRepos, repo, c.httpClient, ctx); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FleetGitRepo)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFleetGitRepo)
+	}
+
+	if err := c.copySecrets(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	repo := &v1alpha1.GitRepoData{}
+	if _, err := util.GetFleetObject(c.rancherHost, c.token, util.FleetGitRepos, workspace(cr), cr.Name, repo, c.httpClient, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	repo.Spec = desiredSpec(cr)
+	repo.Status = nil
+	if err := util.UpdateFleetObject(c.rancherHost, c.token, util.FleetGitRepos, workspace(cr), cr.Name, repo, c.httpClient, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FleetGitRepo)
+	if !ok {
+		return errors.New(errNotFleetGitRepo)
+	}
+
+	if err := util.DeleteFleetObject(c.rancherHost, c.token, util.FleetGitRepos, workspace(cr), cr.Name, c.httpClient, ctx); err != nil {
+		return err
+	}
+	if cr.Spec.ForProvider.ClientSecretRef != nil {
+		if err := util.DeleteFleetSecret(c.rancherHost, c.token, workspace(cr), clientSecretName(cr), c.httpClient, ctx); err != nil {
+			return err
+		}
+	}
+	if cr.Spec.ForProvider.HelmSecretRef != nil {
+		return util.DeleteFleetSecret(c.rancherHost, c.token, workspace(cr), helmSecretName(cr), c.httpClient, ctx)
+	}
+	return nil
+}
+
+// sourceSecrets returns the referenced client and Helm Secrets, either of
+// which may be nil, and a version that changes whenever one of them changes.
+func (c *external) sourceSecrets(ctx context.Context, cr *v1alpha1.FleetGitRepo) (*corev1.Secret, *corev1.Secret, string, error) {
+	var clientSecret, helmSecret *corev1.Secret
+	var versions []string
+	var err error
+	if ref := cr.Spec.ForProvider.ClientSecretRef; ref != nil {
+		if clientSecret, err = util.GetSecret(ctx, c.kube, *ref); err != nil {
+			return nil, nil, "", errors.Wrap(err, errGetSecret)
+		}
+		versions = append(versions, clientSecret.ResourceVersion)
+	}
+	if ref := cr.Spec.ForProvider.HelmSecretRef; ref != nil {
+		if helmSecret, err = util.GetSecret(ctx, c.kube, *ref); err != nil {
+			return nil, nil, "", errors.Wrap(err, errGetSecret)
+		}
+		versions = append(versions, helmSecret.ResourceVersion)
+	}
+	return clientSecret, helmSecret, strings.Join(versions, ","), nil
+}
+
+// copySecrets copies the referenced Secrets into the workspace.
+func (c *external) copySecrets(ctx context.Context, cr *v1alpha1.FleetGitRepo) error {
+	clientSecret, helmSecret, version, err := c.sourceSecrets(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if clientSecret != nil {
+		if err := util.ApplyFleetSecret(c.rancherHost, c.token, workspace(cr), clientSecretName(cr), clientSecret, c.httpClient, ctx); err != nil {
+			return err
+		}
+	}
+	if helmSecret != nil {
+		if err := util.ApplyFleetSecret(c.rancherHost, c.token, workspace(cr), helmSecretName(cr), helmSecret, c.httpClient, ctx); err != nil {
+			return err
+		}
+	}
+	cr.Status.AtProvider.SecretVersion = version
+	return nil
+}
+
+func workspace(cr *v1alpha1.FleetGitRepo) string {
+	if cr.Spec.ForProvider.Namespace != "" {
+		return cr.Spec.ForProvider.Namespace
+	}
+	return defaultWorkspace
+}
+
+// clientSecretName is the name of the copy of ClientSecretRef.
+func clientSecretName(cr *v1alpha1.FleetGitRepo) string {
+	return cr.Name + "-auth"
+}
+
+// helmSecretName is the name of the copy of HelmSecretRef.
+func helmSecretName(cr *v1alpha1.FleetGitRepo) string {
+	return cr.Name + "-helm"
+}
+
+func desiredSpec(cr *v1alpha1.FleetGitRepo) v1alpha1.GitRepoConfig {
+	spec := cr.Spec.ForProvider.GitRepoConfig
+	if cr.Spec.ForProvider.ClientSecretRef != nil {
+		spec.ClientSecretName = clientSecretName(cr)
+	}
+	if cr.Spec.ForProvider.HelmSecretRef != nil {
+		spec.HelmSecretName = helmSecretName(cr)
+	}
+	return spec
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hosted reconciles the clusters Rancher provisions through its EKS,
+// AKS and GKE operators. The controllers of the hosted cluster kinds only
+// adapt their managed resources to the client of this package.
+package hosted
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/hosted/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/internal/metrics"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotCluster   = "managed resource is not a %s custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+	errCompare      = "cannot compare config with upstream spec"
+
+	// stateRemoving is the state of a cluster Rancher is removing.
+	stateRemoving = "removing"
+)
+
+// A Cluster gives the client access to the fields of a hosted cluster managed
+// resource.
+type Cluster interface {
+	resource.Managed
+
+	// Observation returns the observed fields of the cluster.
+	Observation() *v1alpha1.HostedClusterObservation
+	// KubeconfigSecretNamespace returns the namespace of the kubeconfig
+	// secret of the cluster.
+	KubeconfigSecretNamespace() string
+	// CloudCredentialRef returns the name of the cloud credential the
+	// cluster is provisioned with, or an empty string if the spec sets the
+	// ID of the credential.
+	CloudCredentialRef() string
+	// Request returns the body of the requests that create and update the
+	// cluster. credentialID is the ID of the cloud credential resolved from
+	// CloudCredentialRef, if any.
+	Request(credentialID string) v1alpha1.HostedClusterRequest
+	// UpToDate reports whether the upstream spec Rancher reports for the
+	// cluster matches the desired config.
+	UpToDate(cluster *v1alpha1.HostedClusterData) (bool, error)
+}
+
+// A Kind is a kind of hosted cluster managed resource.
+type Kind struct {
+	Kind             string
+	GroupKind        string
+	GroupVersionKind schema.GroupVersionKind
+
+	// Object returns an empty managed resource of the kind.
+	Object func() client.Object
+	// List returns an empty list of managed resources of the kind.
+	List func() client.ObjectList
+	// Cluster returns the Cluster of a managed resource of the kind, or
+	// false if the managed resource is of another kind.
+	Cluster func(mg resource.Managed) (Cluster, bool)
+	// HasConfig reports whether Rancher provisions the cluster through the
+	// operator of the kind, i.e. whether it has the config of the kind.
+	HasConfig func(cluster *v1alpha1.HostedClusterData) bool
+}
+
+// Setup adds a controller that reconciles managed resources of the supplied
+// hosted cluster kind. Clusters are observed from the supplied cache.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache, k Kind) error {
+	name := managed.ControllerName(k.GroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(k.GroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kind:  k,
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			lists: lists})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(k.Object()).
+		Watches(lists.Subscribe(cache.Clusters, cache.ByID(mgr.GetClient(), k.List(), cache.ExternalNameID)),
+			&handler.EnqueueRequestForObject{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kind  Kind
+	kube  client.Client
+	usage resource.Tracker
+	lists *cache.Cache
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := c.kind.Cluster(mg)
+	if !ok {
+		return nil, errors.Errorf(errNotCluster, c.kind.Kind)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{kind: c.kind, httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost, lists: c.lists}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kind        Kind
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+	lists       *cache.Cache
+}
+
+// listKey identifies the Rancher server and credentials of the client in the
+// list cache.
+func (c *external) listKey() cache.Key {
+	return cache.Key{Host: c.rancherHost, Token: c.token}
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := c.kind.Cluster(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errNotCluster, c.kind.Kind)
+	}
+
+	cluster, err := c.cluster(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if cluster == nil {
+		metrics.ForgetCluster(c.kind.Kind, cr.GetName())
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// Clusters found by name are identified by their ID from now on.
+	lateInitialized := false
+	if meta.GetExternalName(cr) != cluster.ID {
+		meta.SetExternalName(cr, cluster.ID)
+		lateInitialized = true
+	}
+
+	o := cr.Observation()
+	o.ID = cluster.ID
+	o.State = cluster.State
+	o.Message = cluster.TransitioningMessage
+	if cluster.Version != nil {
+		o.KubernetesVersion = cluster.Version.GitVersion
+	}
+	metrics.SetClusterState(c.kind.Kind, cr.GetName(), cluster.State)
+
+	// Rancher keeps a cluster it is removing until the cloud resources are
+	// gone. It still exists, but is neither observed nor updated.
+	if cluster.State == stateRemoving {
+		cr.SetConditions(xpv1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: lateInitialized}, nil
+	}
+
+	if cluster.State == "active" {
+		cr.SetConditions(xpv1.Available())
+		err := util.GenerateKubeconfig(ctx, c.rancherHost, cluster.ID, c.token, cr.GetName(), cr.KubeconfigSecretNamespace(), c.httpClient, c.kube)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		generated, err := util.KubeconfigGenerated(ctx, cr.GetName(), cr.KubeconfigSecretNamespace(), c.kube)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		metrics.SetKubeconfigGenerated(c.kind.Kind, cr.GetName(), generated)
+	} else {
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// Rancher reports the spec of the cluster as read from the cloud provider
+	// in its status. Until the first sync it is empty and there is nothing to
+	// compare against.
+	upToDate, err := cr.UpToDate(cluster)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := c.kind.Cluster(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errNotCluster, c.kind.Kind)
+	}
+
+	req, err := c.request(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	id, err := util.CreateHostedCluster(c.rancherHost, c.token, c.httpClient, req, ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	c.lists.Invalidate(c.listKey(), cache.Clusters)
+	// The Rancher ID is persisted as the external name right after Create,
+	// so that the cluster is never created twice or confused with another
+	// cluster of the same name.
+	meta.SetExternalName(cr, id)
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := c.kind.Cluster(mg)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.Errorf(errNotCluster, c.kind.Kind)
+	}
+
+	req, err := c.request(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := util.UpdateHostedCluster(c.rancherHost, c.token, cr.Observation().ID, c.httpClient, req, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	c.lists.Invalidate(c.listKey(), cache.Clusters)
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := c.kind.Cluster(mg)
+	if !ok {
+		return errors.Errorf(errNotCluster, c.kind.Kind)
+	}
+	if err := util.DeleteCluster(c.rancherHost, c.token, cr.Observation().ID, c.httpClient, ctx); err != nil {
+		return err
+	}
+	c.lists.Invalidate(c.listKey(), cache.Clusters)
+	return nil
+}
+
+// cluster returns the Rancher cluster of the managed resource from the list
+// cache, or nil if no such cluster exists.
+func (c *external) cluster(ctx context.Context, cr Cluster) (*v1alpha1.HostedClusterData, error) {
+	clusters := &v1alpha1.HostedClusterResponse{}
+	if err := c.lists.List(ctx, c.listKey(), cache.Clusters, c.httpClient, clusters); err != nil {
+		return nil, err
+	}
+	for i := range clusters.Data {
+		if c.isCluster(cr, &clusters.Data[i]) {
+			return &clusters.Data[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// isCluster reports whether the Rancher cluster is the external resource of
+// the managed resource. Once created, a cluster is identified by its Rancher
+// ID, which is recorded as its external name. Clusters whose ID has not been
+// recorded yet are identified by their name, but only if they are
+// provisioned through the operator of the kind, so that clusters of other
+// kinds are never adopted.
+func (c *external) isCluster(cr Cluster, cluster *v1alpha1.HostedClusterData) bool {
+	if name := meta.GetExternalName(cr); name != "" && name != cr.GetName() {
+		return cluster.ID == name
+	}
+	return cluster.Name == cr.GetName() && c.kind.HasConfig(cluster)
+}
+
+// request returns the body of the requests that create and update the
+// cluster, resolving its cloud credential reference if necessary.
+func (c *external) request(ctx context.Context, cr Cluster) (v1alpha1.HostedClusterRequest, error) {
+	credentialID := ""
+	if ref := cr.CloudCredentialRef(); ref != "" {
+		id, err := util.GetCloudCredentialIDByName(c.rancherHost, c.token, ref, c.httpClient, ctx)
+		if err != nil {
+			return v1alpha1.HostedClusterRequest{}, err
+		}
+		credentialID = id
+	}
+	return cr.Request(credentialID), nil
+}
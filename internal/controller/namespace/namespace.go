@@ -0,0 +1,330 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespace
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotNamespace = "managed resource is not a Namespace custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+	errNoCluster    = "either clusterId or clusterIdRef must be set"
+	errNoProject    = "either projectId or projectIdRef must be set"
+	errProjectRef   = "cannot find project referenced by projectIdRef"
+	errAnnotations  = "cannot build namespace annotations"
+)
+
+// Setup adds a controller that reconciles Namespace managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
+	name := managed.ControllerName(v1alpha1.NamespaceGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.NamespaceGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			lists: lists})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Namespace{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+	lists *cache.Cache
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Namespace)
+	if !ok {
+		return nil, errors.New(errNotNamespace)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost, lists: c.lists}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+	lists       *cache.Cache
+}
+
+// listKey identifies the Rancher server and credentials of the client in the
+// list cache.
+func (c *external) listKey() cache.Key {
+	return cache.Key{Host: c.rancherHost, Token: c.token}
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Namespace)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNamespace)
+	}
+
+	// The cluster and project referenced by a deleted namespace may be gone
+	// already, so deleted namespaces are looked up in the cluster recorded in
+	// their status. A namespace is gone with its cluster.
+	clusterID := cr.Status.AtProvider.ClusterID
+	if meta.WasDeleted(cr) {
+		if clusterID == "" {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		cluster, err := util.GetCluster(c.rancherHost, c.token, clusterID, c.httpClient, ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if cluster == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+	} else {
+		id, err := c.clusterID(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		clusterID = id
+		cr.Status.AtProvider.ClusterID = clusterID
+	}
+
+	ns, err := util.GetNamespace(c.rancherHost, c.token, clusterID, cr.Name, c.httpClient, ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if ns == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	cr.Status.AtProvider.ProjectID = ns.Annotations[util.ProjectIDAnnotation]
+	cr.Status.AtProvider.Phase = string(ns.Status.Phase)
+	if ns.Status.Phase == corev1.NamespaceActive {
+		cr.Status.SetConditions(xpv1.Available())
+	} else {
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	desired, err := c.desiredNamespace(ctx, cr, clusterID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  containsAll(ns.Labels, desired.Labels) && containsAll(ns.Annotations, desired.Annotations),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Namespace)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNamespace)
+	}
+
+	clusterID, err := c.clusterID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	ns, err := c.desiredNamespace(ctx, cr, clusterID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := util.CreateNamespace(c.rancherHost, c.token, clusterID, c.httpClient, ns, ctx); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Namespace)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNamespace)
+	}
+
+	clusterID := cr.Status.AtProvider.ClusterID
+	ns, err := c.desiredNamespace(ctx, cr, clusterID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := util.PatchNamespaceMetadata(c.rancherHost, c.token, clusterID, cr.Name, ns.Labels, ns.Annotations, c.httpClient, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Namespace)
+	if !ok {
+		return errors.New(errNotNamespace)
+	}
+	return util.DeleteNamespace(c.rancherHost, c.token, cr.Status.AtProvider.ClusterID, cr.Name, c.httpClient, ctx)
+}
+
+// clusterID returns the ID of the downstream cluster, resolving clusterIdRef
+// if necessary.
+func (c *external) clusterID(ctx context.Context, cr *v1alpha1.Namespace) (string, error) {
+	p := cr.Spec.ForProvider
+	if p.ClusterID != "" {
+		return p.ClusterID, nil
+	}
+	if p.ClusterIDRef == "" {
+		return "", errors.New(errNoCluster)
+	}
+	return c.lists.ClusterIDByName(ctx, c.listKey(), c.httpClient, p.ClusterIDRef)
+}
+
+// projectID returns the ID of the project the namespace is assigned to,
+// resolving projectIdRef if necessary.
+func (c *external) projectID(ctx context.Context, cr *v1alpha1.Namespace, clusterID string) (string, error) {
+	p := cr.Spec.ForProvider
+	if p.ProjectID != "" {
+		return p.ProjectID, nil
+	}
+	if p.ProjectIDRef == "" {
+		return "", errors.New(errNoProject)
+	}
+	project, err := c.lists.ProjectByName(ctx, c.listKey(), c.httpClient, clusterID, p.ProjectIDRef)
+	if err != nil {
+		return "", err
+	}
+	if project == nil {
+		return "", errors.New(errProjectRef)
+	}
+	return project.ID, nil
+}
+
+// desiredNamespace builds the namespace described by the supplied managed
+// resource. Rancher moves the namespace into the project named by the
+// field.cattle.io/projectId annotation.
+func (c *external) desiredNamespace(ctx context.Context, cr *v1alpha1.Namespace, clusterID string) (*corev1.Namespace, error) {
+	projectID, err := c.projectID(ctx, cr, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	p := cr.Spec.ForProvider
+	annotations := map[string]string{}
+	for k, v := range p.Annotations {
+		annotations[k] = v
+	}
+	annotations[util.ProjectIDAnnotation] = projectID
+	if p.ResourceQuota != nil {
+		b, err := json.Marshal(p.ResourceQuota)
+		if err != nil {
+			return nil, errors.Wrap(err, errAnnotations)
+		}
+		annotations[util.ResourceQuotaAnnotation] = string(b)
+	}
+	if p.ContainerDefaultResourceLimit != nil {
+		b, err := json.Marshal(p.ContainerDefaultResourceLimit)
+		if err != nil {
+			return nil, errors.Wrap(err, errAnnotations)
+		}
+		annotations[util.ContainerDefaultResourceLimitAnnotation] = string(b)
+	}
+
+	return &corev1.Namespace{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cr.Name,
+			Labels:      p.Labels,
+			Annotations: annotations,
+		},
+	}, nil
+}
+
+// containsAll reports whether observed contains every key of desired with the
+// same value.
+func containsAll(observed, desired map[string]string) bool {
+	for k, v := range desired {
+		if observed[k] != v {
+			return false
+		}
+	}
+	return true
+}
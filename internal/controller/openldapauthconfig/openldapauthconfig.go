@@ -0,0 +1,233 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openldapauthconfig
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotOpenLDAPAuthConfig = "managed resource is not a OpenLDAPAuthConfig custom resource"
+	errTrackPCUsage          = "cannot track ProviderConfig usage"
+	errGetPC                 = "cannot get ProviderConfig"
+	errGetCreds              = "cannot get credentials"
+	errCompare               = "cannot compare OpenLDAP auth config with Rancher"
+	errGetSecrets            = "cannot get OpenLDAP auth config secrets"
+)
+
+// Setup adds a controller that reconciles OpenLDAPAuthConfig managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.OpenLDAPAuthConfigGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OpenLDAPAuthConfigGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{})})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.OpenLDAPAuthConfig{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OpenLDAPAuthConfig)
+	if !ok {
+		return nil, errors.New(errNotOpenLDAPAuthConfig)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OpenLDAPAuthConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOpenLDAPAuthConfig)
+	}
+	path := util.OpenLDAPAuthConfig
+	observed := &v1alpha1.OpenLDAPConfigData{}
+	if err := util.GetAuthConfig(c.rancherHost, c.token, path, observed, c.httpClient, ctx); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.Enabled = observed.Enabled
+	if !observed.Enabled {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.Status.SetConditions(xpv1.Available())
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	_, version, err := util.GetSecretValues(ctx, c.kube, cr.Spec.ForProvider.ServiceAccountPasswordSecretRef, cr.Spec.ForProvider.TestPasswordSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSecrets)
+	}
+	upToDate, err := util.IsSubset(desired(cr, nil), observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	if cr.Status.AtProvider.SecretVersion == "" {
+		cr.Status.AtProvider.SecretVersion = cr.GetAnnotations()[util.SecretVersionAnnotation]
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate && version == cr.Status.AtProvider.SecretVersion,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OpenLDAPAuthConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOpenLDAPAuthConfig)
+	}
+	if err := c.apply(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OpenLDAPAuthConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOpenLDAPAuthConfig)
+	}
+	if err := c.apply(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if _, ok := mg.(*v1alpha1.OpenLDAPAuthConfig); !ok {
+		return errors.New(errNotOpenLDAPAuthConfig)
+	}
+	return util.DisableAuthConfig(c.rancherHost, c.token, util.OpenLDAPAuthConfig, c.httpClient, ctx)
+}
+
+// apply tests the configuration by logging in as the test user and enables it.
+func (c *external) apply(ctx context.Context, cr *v1alpha1.OpenLDAPAuthConfig) error {
+	path := util.OpenLDAPAuthConfig
+	secrets, version, err := util.GetSecretValues(ctx, c.kube, cr.Spec.ForProvider.ServiceAccountPasswordSecretRef, cr.Spec.ForProvider.TestPasswordSecretRef)
+	if err != nil {
+		return errors.Wrap(err, errGetSecrets)
+	}
+	config := desired(cr, secrets)
+	input := v1alpha1.OpenLDAPTestAndApplyInput{
+		LDAPConfig: config,
+		Username:   cr.Spec.ForProvider.TestUsername,
+		Password:   string(secrets[1]),
+	}
+	if err := util.AuthConfigAction(c.rancherHost, c.token, path, "testAndApply", input, nil, c.httpClient, ctx); err != nil {
+		return err
+	}
+	// testAndApply allows the test user on top of the allowed principals.
+	// Updating the configuration restores the desired principals.
+	if err := util.UpdateAuthConfig(c.rancherHost, c.token, path, config, c.httpClient, ctx); err != nil {
+		return err
+	}
+	cr.Status.AtProvider.SecretVersion = version
+	meta.AddAnnotations(cr, map[string]string{util.SecretVersionAnnotation: version})
+	return nil
+}
+
+// desired returns the configuration of the provider. Secret values are only
+// set when secrets is not nil.
+func desired(cr *v1alpha1.OpenLDAPAuthConfig, secrets [][]byte) v1alpha1.OpenLDAPConfigData {
+	p := cr.Spec.ForProvider
+	config := v1alpha1.OpenLDAPConfigData{
+		Enabled:          true,
+		OpenLDAPConfig:   p.OpenLDAPConfig,
+		AuthConfigAccess: p.AuthConfigAccess,
+	}
+	if secrets != nil {
+		config.ServiceAccountPassword = string(secrets[0])
+	}
+	return config
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotProject   = "managed resource is not a Project custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+	errNoCluster    = "either clusterId or clusterIdRef must be set"
+	errCompare      = "cannot compare project with Rancher"
+)
+
+// Setup adds a controller that reconciles Project managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
+	name := managed.ControllerName(v1alpha1.ProjectGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProjectGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			lists: lists})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Project{}).
+		Watches(lists.Subscribe(cache.Projects, cache.ByID(mgr.GetClient(), &v1alpha1.ProjectList{}, projectID)),
+			&handler.EnqueueRequestForObject{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+	lists *cache.Cache
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return nil, errors.New(errNotProject)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost, lists: c.lists}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+	lists       *cache.Cache
+}
+
+// listKey identifies the Rancher server and credentials of the client in the
+// list cache.
+func (c *external) listKey() cache.Key {
+	return cache.Key{Host: c.rancherHost, Token: c.token}
+}
+
+// projectID returns the Rancher ID of the project of a Project, which is
+// recorded in its status once observed.
+func projectID(mg client.Object) string {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return ""
+	}
+	return cr.Status.AtProvider.ID
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProject)
+	}
+
+	// The cluster referenced by a deleted project may be gone already, so
+	// deleted projects are looked up in the cluster recorded in their status.
+	clusterID := cr.Status.AtProvider.ClusterID
+	if meta.WasDeleted(cr) {
+		if clusterID == "" {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+	} else {
+		id, err := c.clusterID(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		clusterID = id
+		cr.Status.AtProvider.ClusterID = clusterID
+	}
+
+	project, err := c.lists.ProjectByName(ctx, c.listKey(), c.httpClient, clusterID, cr.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if project == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if meta.WasDeleted(cr) {
+		cr.Status.AtProvider.ID = project.ID
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	cr.Status.AtProvider.ID = project.ID
+	cr.Status.AtProvider.State = project.State
+	if project.State == "active" {
+		cr.Status.SetConditions(xpv1.Available())
+	} else {
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate, err := util.IsSubset(desiredProject(cr, clusterID), project)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+	upToDate = upToDate && project.PodSecurityPolicyTemplateID == cr.Spec.ForProvider.PodSecurityPolicyTemplateID
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProject)
+	}
+
+	clusterID, err := c.clusterID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	projectID, err := util.CreateProject(c.rancherHost, c.token, c.httpClient, desiredProject(cr, clusterID), ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	c.lists.Invalidate(c.listKey(), cache.Projects)
+	if cr.Spec.ForProvider.PodSecurityPolicyTemplateID != "" {
+		if err := util.SetProjectPodSecurityPolicyTemplate(c.rancherHost, c.token, projectID, cr.Spec.ForProvider.PodSecurityPolicyTemplateID, c.httpClient, ctx); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProject)
+	}
+
+	id := cr.Status.AtProvider.ID
+	if err := util.UpdateProject(c.rancherHost, c.token, id, c.httpClient, desiredProject(cr, cr.Status.AtProvider.ClusterID), ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	c.lists.Invalidate(c.listKey(), cache.Projects)
+	if err := util.SetProjectPodSecurityPolicyTemplate(c.rancherHost, c.token, id, cr.Spec.ForProvider.PodSecurityPolicyTemplateID, c.httpClient, ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return errors.New(errNotProject)
+	}
+	if err := util.DeleteProject(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx); err != nil {
+		return err
+	}
+	c.lists.Invalidate(c.listKey(), cache.Projects)
+	return nil
+}
+
+// clusterID returns the ID of the cluster owning the project, resolving
+// clusterIdRef if necessary.
+func (c *external) clusterID(ctx context.Context, cr *v1alpha1.Project) (string, error) {
+	p := cr.Spec.ForProvider
+	if p.ClusterID != "" {
+		return p.ClusterID, nil
+	}
+	if p.ClusterIDRef == "" {
+		return "", errors.New(errNoCluster)
+	}
+	return c.lists.ClusterIDByName(ctx, c.listKey(), c.httpClient, p.ClusterIDRef)
+}
+
+func desiredProject(cr *v1alpha1.Project, clusterID string) v1alpha1.ProjectData {
+	p := cr.Spec.ForProvider
+	return v1alpha1.ProjectData{
+		Name:                          cr.Name,
+		ClusterID:                     clusterID,
+		Description:                   p.Description,
+		Labels:                        p.Labels,
+		Annotations:                   p.Annotations,
+		ResourceQuota:                 p.ResourceQuota,
+		NamespaceDefaultResourceQuota: p.NamespaceDefaultResourceQuota,
+		ContainerDefaultResourceLimit: p.ContainerDefaultResourceLimit,
+	}
+}
@@ -20,18 +20,80 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/activedirectoryauthconfig"
+	"github.com/dormullor/provider-rancher/internal/controller/akscluster"
+	"github.com/dormullor/provider-rancher/internal/controller/app"
+	"github.com/dormullor/provider-rancher/internal/controller/clusterrepo"
+	"github.com/dormullor/provider-rancher/internal/controller/clusterroletemplatebinding"
+	"github.com/dormullor/provider-rancher/internal/controller/clustertemplate"
+	"github.com/dormullor/provider-rancher/internal/controller/clustertemplaterevision"
 	"github.com/dormullor/provider-rancher/internal/controller/config"
+	"github.com/dormullor/provider-rancher/internal/controller/ekscluster"
+	"github.com/dormullor/provider-rancher/internal/controller/etcdsnapshot"
+	"github.com/dormullor/provider-rancher/internal/controller/etcdsnapshotrestore"
+	"github.com/dormullor/provider-rancher/internal/controller/feature"
+	"github.com/dormullor/provider-rancher/internal/controller/fleetclustergroup"
+	"github.com/dormullor/provider-rancher/internal/controller/fleetgitrepo"
+	"github.com/dormullor/provider-rancher/internal/controller/githubauthconfig"
+	"github.com/dormullor/provider-rancher/internal/controller/gkecluster"
+	"github.com/dormullor/provider-rancher/internal/controller/globalrole"
+	"github.com/dormullor/provider-rancher/internal/controller/globalrolebinding"
+	"github.com/dormullor/provider-rancher/internal/controller/keycloakoidcauthconfig"
+	"github.com/dormullor/provider-rancher/internal/controller/namespace"
+	"github.com/dormullor/provider-rancher/internal/controller/openldapauthconfig"
+	"github.com/dormullor/provider-rancher/internal/controller/project"
+	"github.com/dormullor/provider-rancher/internal/controller/projectroletemplatebinding"
 	"github.com/dormullor/provider-rancher/internal/controller/rke1cluster"
 	"github.com/dormullor/provider-rancher/internal/controller/rke1nodetemplate"
+	"github.com/dormullor/provider-rancher/internal/controller/roletemplate"
+	"github.com/dormullor/provider-rancher/internal/controller/samlauthconfig"
+	"github.com/dormullor/provider-rancher/internal/controller/setting"
+	"github.com/dormullor/provider-rancher/internal/controller/token"
+	"github.com/dormullor/provider-rancher/internal/controller/user"
 )
 
 // Setup creates all Rancher controllers with the supplied logger and adds them to
-// the supplied manager.
-func Setup(mgr ctrl.Manager, o controller.Options) error {
-	for _, setup := range []func(ctrl.Manager, controller.Options) error{
-		config.Setup,
+// the supplied manager. Controllers observing shared Rancher collections read
+// them from the supplied cache.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
+	for _, setup := range []func(ctrl.Manager, controller.Options, *cache.Cache) error{
 		rke1cluster.Setup,
 		rke1nodetemplate.Setup,
+		ekscluster.Setup,
+		akscluster.Setup,
+		gkecluster.Setup,
+		project.Setup,
+		namespace.Setup,
+		clusterroletemplatebinding.Setup,
+		projectroletemplatebinding.Setup,
+		globalrolebinding.Setup,
+		token.Setup,
+		app.Setup,
+		clusterrepo.Setup,
+		etcdsnapshot.Setup,
+		etcdsnapshotrestore.Setup,
+	} {
+		if err := setup(mgr, o, lists); err != nil {
+			return err
+		}
+	}
+	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+		config.Setup,
+		roletemplate.Setup,
+		globalrole.Setup,
+		user.Setup,
+		activedirectoryauthconfig.Setup,
+		githubauthconfig.Setup,
+		keycloakoidcauthconfig.Setup,
+		openldapauthconfig.Setup,
+		samlauthconfig.Setup,
+		feature.Setup,
+		setting.Setup,
+		fleetclustergroup.Setup,
+		fleetgitrepo.Setup,
+		clustertemplate.Setup,
+		clustertemplaterevision.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rke1cluster
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+// Connection detail keys of a custom cluster.
+const (
+	keyToken               = "token"
+	keyNodeCommand         = "nodeCommand"
+	keyWindowsNodeCommand  = "windowsNodeCommand"
+	keyInsecureNodeCommand = "insecureNodeCommand"
+)
+
+// isCustom reports whether the nodes of the cluster register themselves
+// rather than being provisioned by node pools.
+func isCustom(cr *v1alpha1.RKE1Cluster) bool {
+	return len(cr.Spec.ForProvider.NodePools) == 0
+}
+
+// registeredNodes counts the nodes registered with a custom cluster.
+func registeredNodes(nodes []v1alpha1.NodeData) *v1alpha1.RegisteredNodes {
+	r := &v1alpha1.RegisteredNodes{Total: len(nodes)}
+	for _, n := range nodes {
+		if n.Etcd {
+			r.Etcd++
+		}
+		if n.ControlPlane {
+			r.ControlPlane++
+		}
+		if n.Worker {
+			r.Worker++
+		}
+		if n.State == "active" {
+			r.Active++
+		}
+	}
+	return r
+}
+
+// registrationDetails returns the commands that register nodes with a custom
+// cluster. A registration token is requested when the cluster has none.
+func (c *external) registrationDetails(ctx context.Context, cr *v1alpha1.RKE1Cluster) (managed.ConnectionDetails, error) {
+	id := cr.Status.AtProvider.ID
+	t, err := util.GetClusterRegistrationToken(c.rancherHost, c.token, id, c.httpClient, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, util.CreateClusterRegistrationToken(c.rancherHost, c.token, id, c.httpClient, ctx)
+	}
+	if t.Token == "" {
+		return nil, nil
+	}
+	return managed.ConnectionDetails{
+		keyToken:               []byte(t.Token),
+		keyNodeCommand:         []byte(t.NodeCommand),
+		keyWindowsNodeCommand:  []byte(t.WindowsNodeCommand),
+		keyInsecureNodeCommand: []byte(t.InsecureNodeCommand),
+	}, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rke1cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	// stateRemoving is the state of a cluster or node Rancher is removing.
+	stateRemoving = "removing"
+	// stateActive is the state of a cluster or etcd snapshot that is ready.
+	stateActive = "active"
+)
+
+const (
+	errDeletionProtected = "cluster is protected from deletion by deletionProtection or its deletion-protection annotation"
+	errWorkloadsRunning  = "cluster still runs workloads in namespaces %s; set deletion.force to delete it anyway"
+	errListWorkloads     = "cannot list the workloads of the cluster; set deletion.force to delete it anyway"
+	errFinalSnapshotGone = "final etcd snapshot %s no longer exists"
+	errFinalSnapshot     = "final etcd snapshot %s failed: %s"
+)
+
+// readyForDeletion reports whether the cluster can be deleted from Rancher.
+// It returns an error when the deletion is refused, and false while the final
+// etcd snapshot has not completed yet or once Rancher is removing the cluster.
+// The reason a deletion is blocked is recorded in the DeletionBlocked
+// condition, which is reset once the cluster is ready for deletion.
+// Workloads are only checked while the cluster is active, since they cannot
+// be listed through Rancher otherwise; broken clusters are deleted anyway.
+func (c *external) readyForDeletion(ctx context.Context, cr *v1alpha1.RKE1Cluster) (bool, error) {
+	// Nothing is left to do for a cluster Rancher is removing already.
+	cluster, err := util.GetCluster(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+	if err != nil || cluster == nil || cluster.State == stateRemoving {
+		return false, err
+	}
+
+	if cr.DeletionProtected() {
+		cr.Status.SetConditions(v1alpha1.DeletionProtected())
+		return false, errors.New(errDeletionProtected)
+	}
+
+	p := cr.Spec.ForProvider.Deletion
+	if (p == nil || !p.Force) && cluster.State == stateActive {
+		namespaces, err := util.ListWorkloadNamespaces(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+		if err != nil {
+			cr.Status.SetConditions(v1alpha1.WorkloadsRunning(err.Error()))
+			return false, errors.Wrap(err, errListWorkloads)
+		}
+		if len(namespaces) > 0 {
+			msg := fmt.Sprintf(errWorkloadsRunning, strings.Join(namespaces, ", "))
+			cr.Status.SetConditions(v1alpha1.WorkloadsRunning(msg))
+			return false, errors.New(msg)
+		}
+	}
+
+	if p == nil || !p.FinalSnapshot {
+		cr.Status.SetConditions(v1alpha1.DeletionAllowed())
+		return true, nil
+	}
+	id := cr.Status.AtProvider.FinalSnapshotID
+	if id == "" {
+		backup, err := util.BackupEtcd(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+		if err != nil {
+			return false, err
+		}
+		cr.Status.AtProvider.FinalSnapshotID = backup.ID
+		cr.Status.SetConditions(v1alpha1.FinalSnapshotPending(backup.ID))
+		return false, nil
+	}
+	backup, err := util.GetEtcdBackup(c.rancherHost, c.token, id, c.httpClient, ctx)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case backup == nil:
+		return false, errors.Errorf(errFinalSnapshotGone, id)
+	case backup.State == "failed" || backup.State == "error":
+		return false, errors.Errorf(errFinalSnapshot, id, backup.TransitioningMessage)
+	case backup.State != stateActive:
+		cr.Status.SetConditions(v1alpha1.FinalSnapshotPending(id))
+		return false, nil
+	}
+	cr.Status.SetConditions(v1alpha1.DeletionAllowed())
+	return true, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rke1cluster
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+// isCluster reports whether the Rancher cluster is the external resource of
+// the managed resource. Once created, a cluster is identified by its Rancher
+// ID, which is recorded as its external name. Clusters whose ID has not been
+// recorded yet, e.g. because the provider stopped right after creating them,
+// are identified by their name.
+func isCluster(cr *v1alpha1.RKE1Cluster, cluster v1alpha1.Data) bool {
+	if name := meta.GetExternalName(cr); name != "" && name != cr.Name {
+		return cluster.ID == name
+	}
+	return cluster.Name == cr.Name
+}
+
+// observeNodePools lists the node pools of the cluster in its status.
+func (c *external) observeNodePools(ctx context.Context, cr *v1alpha1.RKE1Cluster) error {
+	pools, err := util.ListNodePools(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+	if err != nil {
+		return err
+	}
+	observed := make([]v1alpha1.ClusterNodePool, 0, len(pools))
+	for _, p := range pools {
+		observed = append(observed, v1alpha1.ClusterNodePool{
+			ID:             p.ID,
+			Name:           p.Name,
+			HostnamePrefix: p.HostnamePrefix,
+			NodeTemplateID: p.NodeTemplateID,
+			State:          p.State,
+		})
+	}
+	cr.Status.AtProvider.NodePools = observed
+	return nil
+}
+
+// missingNodePools returns the node pools of the spec that were not observed
+// in Rancher.
+func missingNodePools(cr *v1alpha1.RKE1Cluster) []v1alpha1.RKENodePool {
+	observed := map[string]bool{}
+	for _, p := range cr.Status.AtProvider.NodePools {
+		observed[p.HostnamePrefix] = true
+	}
+	var missing []v1alpha1.RKENodePool
+	for _, p := range cr.Spec.ForProvider.NodePools {
+		if !observed[p.HostnamePrefix] {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
+
+// createNodePools creates the node pools missing in Rancher. Pools are created
+// one by one; a pool that could not be created is retried with the next
+// reconcile, while the pools created before it are observed and not created
+// again.
+func (c *external) createNodePools(ctx context.Context, cr *v1alpha1.RKE1Cluster) error {
+	for _, pool := range missingNodePools(cr) {
+		if pool.NodeTemplateIDRef != "" {
+			id, err := util.GetNodeTemplateByName(c.rancherHost, c.token, pool.NodeTemplateIDRef, c.httpClient, ctx)
+			if err != nil {
+				return err
+			}
+			pool.NodeTemplateID = id
+			pool.NodeTemplateIDRef = ""
+		}
+		if err := util.CreateNodePool(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, pool, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodePoolNodes counts the nodes of each node pool by hostname prefix.
+func nodePoolNodes(cr *v1alpha1.RKE1Cluster) map[string]int {
+	prefixes := map[string]string{}
+	counts := map[string]int{}
+	for _, p := range cr.Status.AtProvider.NodePools {
+		prefixes[p.ID] = p.HostnamePrefix
+		counts[p.HostnamePrefix] = 0
+	}
+	for _, n := range cr.Status.AtProvider.Nodes {
+		if prefix, ok := prefixes[n.NodePoolID]; ok {
+			counts[prefix]++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rke1cluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+)
+
+type clusterModifier func(*v1alpha1.RKE1Cluster)
+
+func withSpecPools(prefixes ...string) clusterModifier {
+	return func(cr *v1alpha1.RKE1Cluster) {
+		for _, p := range prefixes {
+			cr.Spec.ForProvider.NodePools = append(cr.Spec.ForProvider.NodePools, v1alpha1.RKENodePool{HostnamePrefix: p, Quantity: 1})
+		}
+	}
+}
+
+func withObservedPool(id, prefix string) clusterModifier {
+	return func(cr *v1alpha1.RKE1Cluster) {
+		cr.Status.AtProvider.NodePools = append(cr.Status.AtProvider.NodePools, v1alpha1.ClusterNodePool{ID: id, HostnamePrefix: prefix})
+	}
+}
+
+func withNode(id, poolID string) clusterModifier {
+	return func(cr *v1alpha1.RKE1Cluster) {
+		cr.Status.AtProvider.Nodes = append(cr.Status.AtProvider.Nodes, v1alpha1.ClusterNode{ID: id, NodePoolID: poolID})
+	}
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.RKE1Cluster {
+	cr := &v1alpha1.RKE1Cluster{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestMissingNodePools(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.RKE1Cluster
+		want   []v1alpha1.RKENodePool
+	}{
+		"NoNodePools": {
+			reason: "Clusters without node pools in their spec miss none.",
+			cr:     cluster(withObservedPool("np-1", "a")),
+		},
+		"NoneObserved": {
+			reason: "All node pools of the spec should be missing before any was observed.",
+			cr:     cluster(withSpecPools("a", "b")),
+			want:   cluster(withSpecPools("a", "b")).Spec.ForProvider.NodePools,
+		},
+		"SomeObserved": {
+			reason: "Only the node pools whose hostname prefix was not observed should be missing.",
+			cr:     cluster(withSpecPools("a", "b", "c"), withObservedPool("np-1", "b")),
+			want:   cluster(withSpecPools("a", "c")).Spec.ForProvider.NodePools,
+		},
+		"AllObserved": {
+			reason: "No node pool should be missing once all were observed.",
+			cr:     cluster(withSpecPools("a", "b"), withObservedPool("np-2", "b"), withObservedPool("np-1", "a")),
+		},
+		"UnknownObserved": {
+			reason: "Node pools observed in Rancher but not in the spec should be ignored.",
+			cr:     cluster(withSpecPools("a"), withObservedPool("np-1", "z")),
+			want:   cluster(withSpecPools("a")).Spec.ForProvider.NodePools,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := missingNodePools(tc.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nmissingNodePools(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNodePoolNodes(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.RKE1Cluster
+		want   map[string]int
+	}{
+		"NoNodePools": {
+			reason: "Clusters without observed node pools should report no counts.",
+			cr:     cluster(withNode("n-1", "")),
+			want:   map[string]int{},
+		},
+		"EmptyNodePool": {
+			reason: "Node pools without nodes should be reported with no nodes.",
+			cr:     cluster(withObservedPool("np-1", "a")),
+			want:   map[string]int{"a": 0},
+		},
+		"NodesPerPool": {
+			reason: "Nodes should be counted by the hostname prefix of their node pool.",
+			cr: cluster(withObservedPool("np-1", "a"), withObservedPool("np-2", "b"),
+				withNode("n-1", "np-1"), withNode("n-2", "np-2"), withNode("n-3", "np-1")),
+			want: map[string]int{"a": 2, "b": 1},
+		},
+		"NodesWithoutPool": {
+			reason: "Nodes that are not part of an observed node pool should not be counted.",
+			cr:     cluster(withObservedPool("np-1", "a"), withNode("n-1", ""), withNode("n-2", "np-9"), withNode("n-3", "np-1")),
+			want:   map[string]int{"a": 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := nodePoolNodes(tc.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nnodePoolNodes(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
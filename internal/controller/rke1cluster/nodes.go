@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rke1cluster
+
+import (
+	"context"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+// Rancher states of a node that is being or has been drained.
+const (
+	nodeDraining = "draining"
+	nodeDrained  = "drained"
+)
+
+// clusterNodes converts the nodes of a cluster as returned by Rancher.
+func clusterNodes(nodes []v1alpha1.NodeData) []v1alpha1.ClusterNode {
+	out := make([]v1alpha1.ClusterNode, 0, len(nodes))
+	for _, n := range nodes {
+		cn := v1alpha1.ClusterNode{
+			ID:                n.ID,
+			Name:              n.NodeName,
+			Hostname:          n.Hostname,
+			NodePoolID:        n.NodePoolID,
+			State:             n.State,
+			IPAddress:         n.IPAddress,
+			ExternalIPAddress: n.ExternalIPAddress,
+			Unschedulable:     n.Unschedulable,
+		}
+		if n.Etcd {
+			cn.Roles = append(cn.Roles, "etcd")
+		}
+		if n.ControlPlane {
+			cn.Roles = append(cn.Roles, "controlplane")
+		}
+		if n.Worker {
+			cn.Roles = append(cn.Roles, "worker")
+		}
+		if n.Info != nil && n.Info.Kubernetes != nil {
+			cn.KubeletVersion = n.Info.Kubernetes.KubeletVersion
+		}
+		out = append(out, cn)
+	}
+	return out
+}
+
+// maintenanceNode returns the observed node a maintenance entry applies to,
+// or nil if there is none.
+func maintenanceNode(cr *v1alpha1.RKE1Cluster, m v1alpha1.NodeMaintenance) *v1alpha1.ClusterNode {
+	for i := range cr.Status.AtProvider.Nodes {
+		n := &cr.Status.AtProvider.Nodes[i]
+		if n.ID == m.Node || (!m.Delete && (n.Name == m.Node || n.Hostname == m.Node)) {
+			return n
+		}
+	}
+	return nil
+}
+
+// maintenanceAction returns the node action required to bring a node to the
+// state requested by a maintenance entry, or an empty string if the node is
+// in that state already.
+func maintenanceAction(m v1alpha1.NodeMaintenance, n *v1alpha1.ClusterNode) string {
+	switch {
+	case m.Delete:
+		if n.State != stateRemoving {
+			return "delete"
+		}
+	case m.Drain != nil:
+		if n.State != nodeDraining && n.State != nodeDrained {
+			return "drain"
+		}
+	case n.State == nodeDraining:
+		return "stopDrain"
+	case m.Cordon:
+		if !n.Unschedulable {
+			return "cordon"
+		}
+	case n.Unschedulable:
+		return "uncordon"
+	}
+	return ""
+}
+
+// maintenancePending reports whether a node is not in the state requested by
+// the node maintenance of the spec.
+func maintenancePending(cr *v1alpha1.RKE1Cluster) bool {
+	for _, m := range cr.Spec.ForProvider.NodeMaintenance {
+		if n := maintenanceNode(cr, m); n != nil && maintenanceAction(m, n) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// maintainNodes runs the node actions required by the node maintenance of the
+// spec.
+func (c *external) maintainNodes(ctx context.Context, cr *v1alpha1.RKE1Cluster) error {
+	for _, m := range cr.Spec.ForProvider.NodeMaintenance {
+		n := maintenanceNode(cr, m)
+		if n == nil {
+			continue
+		}
+		var err error
+		switch maintenanceAction(m, n) {
+		case "delete":
+			err = util.DeleteNode(c.rancherHost, c.token, n.ID, c.httpClient, ctx)
+		case "drain":
+			in := m.Drain.DeepCopy()
+			in.SetDefaults()
+			err = util.DrainNode(c.rancherHost, c.token, n.ID, *in, c.httpClient, ctx)
+		case "stopDrain":
+			err = util.StopDrainNode(c.rancherHost, c.token, n.ID, c.httpClient, ctx)
+		case "cordon":
+			err = util.CordonNode(c.rancherHost, c.token, n.ID, c.httpClient, ctx)
+		case "uncordon":
+			err = util.UncordonNode(c.rancherHost, c.token, n.ID, c.httpClient, ctx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
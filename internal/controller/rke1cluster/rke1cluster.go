@@ -19,24 +19,29 @@ package rke1cluster
 import (
 	"context"
 	b64 "encoding/base64"
-	"fmt"
 	"net/http"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
 	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
 	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/internal/metrics"
 	"github.com/dormullor/provider-rancher/util"
 )
 
@@ -45,10 +50,12 @@ const (
 	errTrackPCUsage = "cannot track ProviderConfig usage"
 	errGetPC        = "cannot get ProviderConfig"
 	errGetCreds     = "cannot get credentials"
+	errGetRevision  = "cannot get ClusterTemplateRevision referenced by clusterTemplateRevisionRef"
+	errRevNotReady  = "ClusterTemplateRevision referenced by clusterTemplateRevisionRef has not been created yet"
 )
 
 // Setup adds a controller that reconciles Cluster managed resources.
-func Setup(mgr ctrl.Manager, o controller.Options) error {
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
 	name := managed.ControllerName(v1alpha1.ClusterGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -56,11 +63,13 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
+	q := terminal.New(o.PollInterval)
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ClusterGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(q.Connecter(&connector{
 			kube:  mgr.GetClient(),
-			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{})}),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			lists: lists})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...))
@@ -69,7 +78,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.RKE1Cluster{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Watches(lists.Subscribe(cache.Clusters, cache.ByID(mgr.GetClient(), &v1alpha1.RKE1ClusterList{}, cache.ExternalNameID)),
+			&handler.EnqueueRequestForObject{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -77,6 +88,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube  client.Client
 	usage resource.Tracker
+	lists *cache.Cache
 }
 
 // Connect typically produces an ExternalClient
@@ -102,8 +114,8 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 	rancherHost := pc.Spec.RancherHost
 	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
-	client := &http.Client{}
-	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost}, nil
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost, lists: c.lists}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -113,6 +125,13 @@ type external struct {
 	token       string
 	rancherHost string
 	kube        client.Client
+	lists       *cache.Cache
+}
+
+// listKey identifies the Rancher server and credentials of the client in the
+// list cache.
+func (c *external) listKey() cache.Key {
+	return cache.Key{Host: c.rancherHost, Token: c.token}
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -121,61 +140,149 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotCluster)
 	}
 
-	results, err := util.GetClusters(c.rancherHost, c.token, c.httpClient, ctx)
+	// Defaults are applied here as well as by the defaulting webhook, so that
+	// clusters managed without webhooks are late-initialized with them.
+	rke := cr.Spec.ForProvider.RKE.RKEClusterSpec.DeepCopy()
+	cr.Default()
+	defaulted := !equality.Semantic.DeepEqual(*rke, cr.Spec.ForProvider.RKE.RKEClusterSpec)
+
+	results := v1alpha1.ClusterResponse{}
+	err := c.lists.List(ctx, c.listKey(), cache.Clusters, c.httpClient, &results)
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 	clusterFound := false
+	details := managed.ConnectionDetails{}
 	for _, cluster := range results.Data {
-		if cluster.Name == cr.Name {
+		if isCluster(cr, cluster) {
 			clusterFound = true
+			if cr.Status.AtProvider.ID == "" {
+				recordInitialRotation(cr)
+			}
 			cr.Status.AtProvider.ID = cluster.ID
+			if meta.GetExternalName(cr) != cluster.ID {
+				meta.SetExternalName(cr, cluster.ID)
+				defaulted = true
+			}
+			metrics.SetClusterState(v1alpha1.ClusterKind, cr.Name, cluster.State)
+			// Rancher keeps a cluster it is removing until its nodes are
+			// gone. It still exists, but is neither observed nor updated.
+			if cluster.State == stateRemoving {
+				cr.Status.SetConditions(xpv1.Deleting())
+				return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+			}
+			if cluster.RKEConfig != nil {
+				cr.Status.AtProvider.KubernetesVersion = cluster.RKEConfig.Version
+			}
+			if err := c.observeUpgrade(ctx, cr, cluster.State); err != nil {
+				return managed.ExternalObservation{}, err
+			}
+			nodes, err := util.ListNodes(c.rancherHost, c.token, cluster.ID, c.httpClient, ctx)
+			if err != nil {
+				return managed.ExternalObservation{}, err
+			}
+			cr.Status.AtProvider.Nodes = clusterNodes(nodes)
+			if !isCustom(cr) {
+				if err := c.observeNodePools(ctx, cr); err != nil {
+					return managed.ExternalObservation{}, err
+				}
+				metrics.SetNodePoolNodes(v1alpha1.ClusterKind, cr.Name, nodePoolNodes(cr))
+			}
+			// Custom clusters only become active once their nodes have
+			// registered, so they are observed in every state.
+			if isCustom(cr) {
+				cr.Status.AtProvider.RegisteredNodes = registeredNodes(nodes)
+				if details, err = c.registrationDetails(ctx, cr); err != nil {
+					return managed.ExternalObservation{}, err
+				}
+			}
 			if cluster.State == "active" {
 				cr.Status.SetConditions(xpv1.Available())
 				err := util.GenerateKubeconfig(ctx, c.rancherHost, cluster.ID, c.token, cr.Name, cr.Spec.ForProvider.KubeconfigSecretNamespace, c.httpClient, c.kube)
 				if err != nil {
 					return managed.ExternalObservation{}, err
 				}
+				generated, err := util.KubeconfigGenerated(ctx, cr.Name, cr.Spec.ForProvider.KubeconfigSecretNamespace, c.kube)
+				if err != nil {
+					return managed.ExternalObservation{}, err
+				}
+				metrics.SetKubeconfigGenerated(v1alpha1.ClusterKind, cr.Name, generated)
+				if err := c.observeEtcdSnapshots(ctx, cr); err != nil {
+					return managed.ExternalObservation{}, err
+				}
+				if err := c.observeRotation(ctx, cr, cluster.State); err != nil {
+					return managed.ExternalObservation{}, err
+				}
 			} else {
 				cr.Status.SetConditions(xpv1.Unavailable())
 			}
 		}
 	}
 
+	if !clusterFound {
+		metrics.ForgetCluster(v1alpha1.ClusterKind, cr.Name)
+	}
+
 	return managed.ExternalObservation{
-		ResourceExists:    clusterFound,
-		ResourceUpToDate:  true,
-		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceExists:          clusterFound,
+		ResourceLateInitialized: clusterFound && defaulted,
+		ResourceUpToDate:        len(missingNodePools(cr)) == 0 && !rotationPending(cr) && !upgradePending(cr) && !maintenancePending(cr),
+		ConnectionDetails:       details,
 	}, nil
 }
 
+// observeEtcdSnapshots lists the etcd snapshots of the cluster in its status.
+func (c *external) observeEtcdSnapshots(ctx context.Context, cr *v1alpha1.RKE1Cluster) error {
+	backups, err := util.ListEtcdBackups(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+	if err != nil {
+		return err
+	}
+	snapshots := make([]v1alpha1.ClusterEtcdSnapshot, 0, len(backups))
+	for _, b := range backups {
+		snapshots = append(snapshots, v1alpha1.ClusterEtcdSnapshot{
+			ID:       b.ID,
+			Filename: b.Filename,
+			Manual:   b.Manual,
+			State:    b.State,
+			Created:  b.Created,
+		})
+	}
+	cr.Status.AtProvider.EtcdSnapshots = snapshots
+	return nil
+}
+
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.RKE1Cluster)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotCluster)
 	}
 
-	for index, node := range cr.Spec.ForProvider.NodePools {
-		if node.NodeTemplateIDRef != "" {
-			nodeTemplateID, err := util.GetNodeTemplateByName(c.rancherHost, c.token, node.NodeTemplateIDRef, c.httpClient, ctx)
-			if err != nil {
-				return managed.ExternalCreation{}, err
-			}
-			cr.Spec.ForProvider.NodePools[index].NodeTemplateID = nodeTemplateID
-		}
-	}
-
-	clusterId, err := util.CreateCluster(c.rancherHost, c.token, c.httpClient, cr, ctx)
+	revisionID, err := c.templateRevisionID(ctx, cr)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
-
-	for _, node := range cr.Spec.ForProvider.NodePools {
-		err := util.CreateNodePool(c.rancherHost, c.token, clusterId, c.httpClient, node, ctx)
-		if err != nil {
+	var clusterId string
+	if revisionID != "" {
+		clusterId, err = util.CreateClusterFromTemplate(c.rancherHost, c.token, c.httpClient, clusterFromTemplate(cr, revisionID), ctx)
+	} else {
+		// Credentials are resolved into a copy so that they never end up in
+		// the spec, which is persisted after Create.
+		desired := cr.DeepCopy()
+		if err := util.ResolveRKECredentials(ctx, c.kube, &desired.Spec.ForProvider.RKE.RKEClusterSpec); err != nil {
 			return managed.ExternalCreation{}, err
 		}
+		clusterId, err = util.CreateCluster(c.rancherHost, c.token, c.httpClient, desired, ctx)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, err
 	}
+	c.lists.Invalidate(c.listKey(), cache.Clusters)
+	// The Rancher ID is persisted as the external name right after Create,
+	// so that the cluster is never created twice. Its node pools are created
+	// by Update once the cluster has been observed.
+	meta.SetExternalName(cr, clusterId)
+	cr.Status.AtProvider.ID = clusterId
+
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
 	}, nil
@@ -187,7 +294,24 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotCluster)
 	}
 
-	fmt.Printf("Updating: %+v", cr)
+	if err := c.createNodePools(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if upgradePending(cr) {
+		if err := c.upgrade(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	} else if rotationPending(cr) && !rotationInProgress(cr) && !upgradeInProgress(cr) {
+		if err := c.rotateCertificates(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	// Rancher cordons and drains nodes itself during upgrades.
+	if maintenancePending(cr) && !upgradeInProgress(cr) {
+		if err := c.maintainNodes(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
 
 	return managed.ExternalUpdate{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -199,9 +323,44 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	if !ok {
 		return errors.New(errNotCluster)
 	}
-	err := util.DeleteCluster(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+	ready, err := c.readyForDeletion(ctx, cr)
+	if err != nil || !ready {
+		return err
+	}
+	err = util.DeleteCluster(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
 	if err != nil {
 		return err
 	}
+	c.lists.Invalidate(c.listKey(), cache.Clusters)
 	return nil
 }
+
+// templateRevisionID returns the ID of the cluster template revision the
+// cluster is created from, resolving clusterTemplateRevisionRef if necessary.
+// Clusters not created from a template return an empty ID.
+func (c *external) templateRevisionID(ctx context.Context, cr *v1alpha1.RKE1Cluster) (string, error) {
+	p := cr.Spec.ForProvider
+	if p.ClusterTemplateRevisionID != "" || p.ClusterTemplateRevisionRef == "" {
+		return p.ClusterTemplateRevisionID, nil
+	}
+	rev := &v1alpha1.ClusterTemplateRevision{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: p.ClusterTemplateRevisionRef}, rev); err != nil {
+		return "", errors.Wrap(err, errGetRevision)
+	}
+	if rev.Status.AtProvider.ID == "" {
+		return "", errors.New(errRevNotReady)
+	}
+	return rev.Status.AtProvider.ID, nil
+}
+
+func clusterFromTemplate(cr *v1alpha1.RKE1Cluster, revisionID string) v1alpha1.ClusterFromTemplateRequest {
+	req := v1alpha1.ClusterFromTemplateRequest{
+		Name:                      cr.Name,
+		ClusterTemplateRevisionID: revisionID,
+		Labels:                    cr.Spec.ForProvider.RKE.Labels,
+	}
+	if len(cr.Spec.ForProvider.Answers) > 0 {
+		req.Answers = &v1alpha1.ClusterAnswers{Values: cr.Spec.ForProvider.Answers}
+	}
+	return req
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rke1cluster
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+// rotationSettle is how long after a certificate rotation was requested an
+// active cluster is taken as the rotation having completed, since Rancher only
+// moves the cluster out of active some time after the request.
+const rotationSettle = time.Minute
+
+// rotationPending reports whether the spec requests a certificate rotation
+// that has not been requested from Rancher yet.
+func rotationPending(cr *v1alpha1.RKE1Cluster) bool {
+	r := cr.Spec.ForProvider.CertificateRotation
+	if r == nil {
+		return false
+	}
+	s := cr.Status.AtProvider.CertificateRotation
+	return s == nil || r.Generation > s.Generation
+}
+
+// rotationInProgress reports whether the last requested certificate rotation
+// has not completed yet.
+func rotationInProgress(cr *v1alpha1.RKE1Cluster) bool {
+	s := cr.Status.AtProvider.CertificateRotation
+	if s == nil || s.RequestedAt == nil {
+		return false
+	}
+	return s.LastRotationTime == nil || s.LastRotationTime.Before(s.RequestedAt)
+}
+
+// recordInitialRotation records the generation of the certificate rotation of
+// a cluster observed for the first time as rotated, since the certificates of
+// a new cluster have just been issued. It is recorded by Observe rather than
+// Create, whose status changes are not persisted.
+func recordInitialRotation(cr *v1alpha1.RKE1Cluster) {
+	if r := cr.Spec.ForProvider.CertificateRotation; r != nil {
+		cr.Status.AtProvider.CertificateRotation = &v1alpha1.CertificateRotationStatus{Generation: r.Generation}
+	}
+}
+
+// rotateCertificates requests the certificate rotation in the spec.
+func (c *external) rotateCertificates(ctx context.Context, cr *v1alpha1.RKE1Cluster) error {
+	r := cr.Spec.ForProvider.CertificateRotation
+	input := v1alpha1.RotateCertificateInput{
+		CACertificates: r.CACertificates,
+		Services:       r.Service,
+	}
+	if err := util.RotateCertificates(c.rancherHost, c.token, cr.Status.AtProvider.ID, input, c.httpClient, ctx); err != nil {
+		return err
+	}
+	now := metav1.Now()
+	s := &v1alpha1.CertificateRotationStatus{Generation: r.Generation, RequestedAt: &now}
+	if prev := cr.Status.AtProvider.CertificateRotation; prev != nil {
+		s.LastRotationTime = prev.LastRotationTime
+	}
+	cr.Status.AtProvider.CertificateRotation = s
+	return nil
+}
+
+// observeRotation completes a certificate rotation in progress once the
+// cluster is active again, publishing a kubeconfig with the new certificates.
+func (c *external) observeRotation(ctx context.Context, cr *v1alpha1.RKE1Cluster, state string) error {
+	if !rotationInProgress(cr) {
+		return nil
+	}
+	s := cr.Status.AtProvider.CertificateRotation
+	if state != "active" || time.Since(s.RequestedAt.Time) < rotationSettle {
+		return nil
+	}
+	if err := util.RefreshKubeconfig(ctx, c.rancherHost, cr.Status.AtProvider.ID, c.token, cr.Name, cr.Spec.ForProvider.KubeconfigSecretNamespace, c.httpClient, c.kube); err != nil {
+		return err
+	}
+	now := metav1.Now()
+	s.LastRotationTime = &now
+	return nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rke1cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errUnsupportedVersion = "kubernetes version %s is not supported by Rancher, supported versions are %s"
+	errDowngrade          = "refusing to downgrade kubernetes from %s to %s"
+	errSnapshotGone       = "etcd snapshot %s taken before the upgrade no longer exists"
+	errSnapshotFailed     = "etcd snapshot %s taken before the upgrade failed: %s"
+
+	defaultUpgradeTimeout = time.Hour
+)
+
+// upgradePending reports whether the spec requests a Kubernetes version other
+// than the one configured in Rancher.
+func upgradePending(cr *v1alpha1.RKE1Cluster) bool {
+	desired := cr.Spec.ForProvider.RKE.RKEClusterSpec.Version
+	observed := cr.Status.AtProvider.KubernetesVersion
+	return desired != "" && observed != "" && desired != observed
+}
+
+// upgradeInProgress reports whether the last started upgrade has not
+// completed yet.
+func upgradeInProgress(cr *v1alpha1.RKE1Cluster) bool {
+	u := cr.Status.AtProvider.Upgrade
+	return u != nil && u.StartedAt != nil && u.CompletedAt == nil
+}
+
+// validateUpgrade checks that Rancher can deploy the desired Kubernetes
+// version and that it is not older than the observed one.
+func (c *external) validateUpgrade(ctx context.Context, observed, desired string) error {
+	versions, err := util.GetKubernetesVersions(c.rancherHost, c.token, c.httpClient, ctx)
+	if err != nil {
+		return err
+	}
+	// Rancher versions without the version settings cannot be validated.
+	supported := len(versions) == 0
+	for _, v := range versions {
+		if v == desired {
+			supported = true
+		}
+	}
+	if !supported {
+		return errors.Errorf(errUnsupportedVersion, desired, strings.Join(versions, ", "))
+	}
+	cmp, err := util.CompareKubernetesVersions(desired, observed)
+	if err != nil {
+		return err
+	}
+	if cmp < 0 {
+		return errors.Errorf(errDowngrade, observed, desired)
+	}
+	return nil
+}
+
+// upgrade starts the upgrade to the Kubernetes version in the spec, first
+// taking an etcd snapshot and waiting for it to complete if configured.
+func (c *external) upgrade(ctx context.Context, cr *v1alpha1.RKE1Cluster) error {
+	desired := cr.Spec.ForProvider.RKE.RKEClusterSpec.Version
+	observed := cr.Status.AtProvider.KubernetesVersion
+	if err := c.validateUpgrade(ctx, observed, desired); err != nil {
+		return err
+	}
+
+	u := cr.Status.AtProvider.Upgrade
+	if u == nil || u.ToVersion != desired || u.StartedAt != nil {
+		u = &v1alpha1.UpgradeStatus{FromVersion: observed, ToVersion: desired}
+		cr.Status.AtProvider.Upgrade = u
+	}
+
+	if p := cr.Spec.ForProvider.Upgrade; p != nil && p.SnapshotBeforeUpgrade {
+		if u.SnapshotID == "" {
+			backup, err := util.BackupEtcd(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+			if err != nil {
+				return err
+			}
+			u.SnapshotID = backup.ID
+			return nil
+		}
+		backup, err := util.GetEtcdBackup(c.rancherHost, c.token, u.SnapshotID, c.httpClient, ctx)
+		if err != nil {
+			return err
+		}
+		switch {
+		case backup == nil:
+			return errors.Errorf(errSnapshotGone, u.SnapshotID)
+		case backup.State == "failed" || backup.State == "error":
+			return errors.Errorf(errSnapshotFailed, u.SnapshotID, backup.TransitioningMessage)
+		case backup.State != "active":
+			return nil
+		}
+	}
+
+	strategy := cr.Spec.ForProvider.RKE.RKEClusterSpec.UpgradeStrategy
+	if err := util.UpgradeCluster(c.rancherHost, c.token, cr.Status.AtProvider.ID, desired, strategy, c.httpClient, ctx); err != nil {
+		return err
+	}
+	now := metav1.Now()
+	u.StartedAt = &now
+	cr.Status.AtProvider.KubernetesVersion = desired
+	cr.Status.SetConditions(v1alpha1.Upgrading())
+	return nil
+}
+
+// observeUpgrade reports the progress of an upgrade in progress per node. The
+// upgrade completes once the cluster and all its nodes are active and all
+// kubelets run the new version.
+func (c *external) observeUpgrade(ctx context.Context, cr *v1alpha1.RKE1Cluster, state string) error {
+	if !upgradeInProgress(cr) {
+		return nil
+	}
+	u := cr.Status.AtProvider.Upgrade
+	nodes, err := util.ListNodes(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+	if err != nil {
+		return err
+	}
+	want := util.KubeletVersion(u.ToVersion)
+	done := state == "active"
+	u.Nodes = make([]v1alpha1.NodeUpgradeStatus, 0, len(nodes))
+	for _, n := range nodes {
+		s := v1alpha1.NodeUpgradeStatus{Name: n.NodeName, State: n.State}
+		if s.Name == "" {
+			s.Name = n.Hostname
+		}
+		if n.Info != nil && n.Info.Kubernetes != nil {
+			s.KubeletVersion = n.Info.Kubernetes.KubeletVersion
+		}
+		s.Upgraded = s.KubeletVersion == want
+		if !s.Upgraded || n.State != "active" {
+			done = false
+		}
+		u.Nodes = append(u.Nodes, s)
+	}
+
+	switch {
+	case done:
+		now := metav1.Now()
+		u.CompletedAt = &now
+		cr.Status.SetConditions(v1alpha1.UpgradeComplete())
+	case time.Since(u.StartedAt.Time) > upgradeTimeout(cr):
+		cr.Status.SetConditions(v1alpha1.UpgradeTimedOut(fmt.Sprintf("upgrade from %s to %s has not completed after %s", u.FromVersion, u.ToVersion, upgradeTimeout(cr))))
+	default:
+		cr.Status.SetConditions(v1alpha1.Upgrading())
+	}
+	return nil
+}
+
+func upgradeTimeout(cr *v1alpha1.RKE1Cluster) time.Duration {
+	if p := cr.Spec.ForProvider.Upgrade; p != nil && p.Timeout != nil {
+		return p.Timeout.Duration
+	}
+	return defaultUpgradeTimeout
+}
@@ -22,11 +22,13 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -39,7 +41,9 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
 	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
 	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
 	"github.com/dormullor/provider-rancher/util"
 )
 
@@ -49,11 +53,12 @@ const (
 	errGetPC                  = "cannot get ProviderConfig"
 	errGetCreds               = "cannot get credentials"
 	errCreateRKE1NodeTemplate = "cannot create RKE1NodeTemplate"
+	msgTemplateInUse          = "node template is still used by node pools %s"
 	ManagedByCrossplane       = "crossplane"
 )
 
 // Setup adds a controller that reconciles RKE1NodeTemplate managed resources.
-func Setup(mgr ctrl.Manager, o controller.Options) error {
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
 	name := managed.ControllerName(v1alpha1.RKE1NodeTemplateGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -61,11 +66,13 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
+	q := terminal.New(o.PollInterval)
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.RKE1NodeTemplateGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(q.Connecter(&connector{
 			kube:  mgr.GetClient(),
-			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{})}),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			lists: lists})),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...))
@@ -74,7 +81,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.RKE1NodeTemplate{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Watches(lists.Subscribe(cache.NodeTemplates, cache.ByID(mgr.GetClient(), &v1alpha1.RKE1NodeTemplateList{}, templateID)),
+			&handler.EnqueueRequestForObject{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -82,6 +91,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube  client.Client
 	usage resource.Tracker
+	lists *cache.Cache
 }
 
 // Connect typically produces an ExternalClient by:
@@ -127,13 +137,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 
 	rancherHost := pc.Spec.RancherHost
 	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
-	client := &http.Client{}
+	client := util.NewHTTPClient(pc)
 	return &external{
 		httpClient:     *client,
 		token:          token,
 		kube:           c.kube,
 		rancherHost:    rancherHost,
 		awsCredentials: awsCredentials,
+		lists:          c.lists,
 	}, nil
 }
 
@@ -145,6 +156,23 @@ type external struct {
 	rancherHost    string
 	kube           client.Client
 	awsCredentials *credentials.Credentials
+	lists          *cache.Cache
+}
+
+// listKey identifies the Rancher server and credentials of the client in the
+// list cache.
+func (c *external) listKey() cache.Key {
+	return cache.Key{Host: c.rancherHost, Token: c.token}
+}
+
+// templateID returns the Rancher ID of the node template of a RKE1NodeTemplate, which is
+// recorded in its status once observed.
+func templateID(mg client.Object) string {
+	cr, ok := mg.(*v1alpha1.RKE1NodeTemplate)
+	if !ok {
+		return ""
+	}
+	return cr.Status.AtProvider.ID
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -153,7 +181,8 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotRKE1NodeTemplate)
 	}
 
-	results, err := util.GetNodeTemplates(c.rancherHost, c.token, c.httpClient, ctx)
+	results := v1alpha1.ClusterResponse{}
+	err := c.lists.List(ctx, c.listKey(), cache.NodeTemplates, c.httpClient, &results)
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -163,7 +192,9 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		if template.Name == cr.Name {
 			templateFound = true
 			cr.Status.AtProvider.ID = template.ID
-			if template.State == "active" {
+			if template.State == "removing" {
+				cr.Status.SetConditions(xpv1.Deleting())
+			} else if template.State == "active" {
 				cr.Status.SetConditions(xpv1.Available())
 			} else {
 				cr.Status.SetConditions(xpv1.Unavailable())
@@ -208,10 +239,15 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		cr.Spec.ForProvider.Amazonec2Config.SubnetID = subnetID
 	}
 
-	_, err := util.CreateNodeTemplate(c.rancherHost, c.token, c.httpClient, *cr, ctx)
+	desired := cr.DeepCopy()
+	if err := util.ResolveNodeTemplateCredentials(ctx, c.kube, &desired.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	_, err := util.CreateNodeTemplate(c.rancherHost, c.token, c.httpClient, *desired, ctx)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRKE1NodeTemplate)
 	}
+	c.lists.Invalidate(c.listKey(), cache.NodeTemplates)
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -238,9 +274,33 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	if !ok {
 		return errors.New(errNotRKE1NodeTemplate)
 	}
-	err := util.DeleteNodeTemplate(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+	// Rancher refuses to delete a template that node pools still use, which
+	// they do until the clusters they belong to are removed. The deletion is
+	// retried with the next reconcile rather than reported as an error. The
+	// pools are listed in the DeletionBlocked condition, since the Ready
+	// condition is reset to Deleting after every deletion attempt.
+	pools, err := util.ListNodePoolsByTemplate(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+	if err != nil {
+		return err
+	}
+	if len(pools) > 0 {
+		names := make([]string, 0, len(pools))
+		for _, p := range pools {
+			// Pools are reported by their hostname prefix, which
+			// identifies them in the spec of their RKE1Cluster.
+			name := p.HostnamePrefix
+			if name == "" {
+				name = p.Name
+			}
+			names = append(names, fmt.Sprintf("%s of cluster %s", name, p.ClusterID))
+		}
+		cr.Status.SetConditions(v1alpha1.NodePoolsRemaining(fmt.Sprintf(msgTemplateInUse, strings.Join(names, ", "))))
+		return nil
+	}
+	err = util.DeleteNodeTemplate(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
 	if err != nil {
 		return err
 	}
+	c.lists.Invalidate(c.listKey(), cache.NodeTemplates)
 	return nil
 }
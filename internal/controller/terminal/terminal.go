@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package terminal keeps managed resources whose Create or Update failed with
+// a terminal error from being requeued with backoff. Sending the same request
+// again cannot succeed, so they are only retried once they change or when
+// they are next polled.
+package terminal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const errTerminal = "terminal error, not retried until the resource changes or is next polled"
+
+// A Requeuer records the managed resources whose Create or Update failed with
+// a terminal error, and requeues them after the poll interval.
+type Requeuer struct {
+	poll time.Duration
+
+	// failed holds the names of the managed resources whose last Create or
+	// Update failed with a terminal error.
+	failed sync.Map
+}
+
+// New returns a Requeuer that requeues managed resources after the supplied
+// poll interval.
+func New(poll time.Duration) *Requeuer {
+	return &Requeuer{poll: poll}
+}
+
+// Connecter wraps c so that the terminal errors returned by the Create and
+// Update methods of its ExternalClients are recorded and wrapped.
+func (q *Requeuer) Connecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		e, err := c.Connect(ctx, mg)
+		if err != nil {
+			return nil, err
+		}
+		return &external{ExternalClient: e, q: q}, nil
+	})
+}
+
+// Reconciler wraps r so that managed resources whose Create or Update just
+// failed with a terminal error are requeued after the poll interval rather
+// than with backoff. Changes to them are still reconciled right away.
+func (q *Requeuer) Reconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		res, err := r.Reconcile(ctx, req)
+		if _, failed := q.failed.LoadAndDelete(req.NamespacedName); failed && err == nil && res.Requeue {
+			return reconcile.Result{RequeueAfter: q.poll}, nil
+		}
+		return res, err
+	})
+}
+
+func (q *Requeuer) record(mg resource.Managed, err error) error {
+	key := types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetName()}
+	if !util.IsTerminal(err) {
+		q.failed.Delete(key)
+		return err
+	}
+	q.failed.Store(key, true)
+	return errors.Wrap(err, errTerminal)
+}
+
+type external struct {
+	managed.ExternalClient
+	q *Requeuer
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	o, err := e.ExternalClient.Create(ctx, mg)
+	return o, e.q.record(mg, err)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	o, err := e.ExternalClient.Update(ctx, mg)
+	return o, e.q.record(mg, err)
+}
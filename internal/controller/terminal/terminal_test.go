@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const poll = time.Minute
+
+func TestRequeuer(t *testing.T) {
+	errTerminalAPI := &util.APIError{Method: http.MethodPost, URL: "https://rancher.example.org/v3/tokens", StatusCode: http.StatusUnprocessableEntity}
+	errRetryableAPI := &util.APIError{Method: http.MethodPost, URL: "https://rancher.example.org/v3/tokens", StatusCode: http.StatusServiceUnavailable}
+
+	type want struct {
+		err    error
+		result reconcile.Result
+	}
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   want
+	}{
+		"Success": {
+			reason: "Successful creations should be reconciled as usual.",
+			want:   want{result: reconcile.Result{RequeueAfter: poll}},
+		},
+		"Retryable": {
+			reason: "Retryable errors should be returned unchanged and requeued with backoff.",
+			err:    errRetryableAPI,
+			want:   want{err: errRetryableAPI, result: reconcile.Result{Requeue: true}},
+		},
+		"Terminal": {
+			reason: "Terminal errors should be wrapped and requeued after the poll interval.",
+			err:    errTerminalAPI,
+			want:   want{err: errors.Wrap(errTerminalAPI, errTerminal), result: reconcile.Result{RequeueAfter: poll}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			q := New(poll)
+			c := q.Connecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						return managed.ExternalCreation{}, tc.err
+					},
+				}, nil
+			}))
+
+			// Like the managed reconciler, requeue with backoff after errors
+			// and after the poll interval otherwise.
+			r := q.Reconciler(reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+				cr := &v1alpha1.Token{ObjectMeta: metav1.ObjectMeta{Name: req.Name}}
+				e, err := c.Connect(ctx, cr)
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, err = e.Create(ctx, cr)
+				if diff := cmp.Diff(tc.want.err, err, equateErrors()); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+				}
+				if err != nil {
+					return reconcile.Result{Requeue: true}, nil
+				}
+				return reconcile.Result{RequeueAfter: poll}, nil
+			}))
+
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// equateErrors considers errors equal when their messages are.
+func equateErrors() cmp.Option {
+	return cmp.Comparer(func(a, b error) bool {
+		if a == nil || b == nil {
+			return a == nil && b == nil
+		}
+		return a.Error() == b.Error()
+	})
+}
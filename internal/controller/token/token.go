@@ -0,0 +1,286 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package token
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/cache"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotToken     = "managed resource is not a Token custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+	errParseExpiry  = "cannot parse token expiry"
+)
+
+// Setup adds a controller that reconciles Token managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options, lists *cache.Cache) error {
+	name := managed.ControllerName(v1alpha1.TokenGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TokenGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			lists: lists})),
+		// The external name is the ID Rancher assigns to the token.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Token{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+	lists *cache.Cache
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Token)
+	if !ok {
+		return nil, errors.New(errNotToken)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost, lists: c.lists}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+	lists       *cache.Cache
+}
+
+// listKey identifies the Rancher server and credentials of the client in the
+// list cache.
+func (c *external) listKey() cache.Key {
+	return cache.Key{Host: c.rancherHost, Token: c.token}
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Token)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotToken)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	observed, err := util.GetToken(c.rancherHost, c.token, id, c.httpClient, ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if observed == nil {
+		cr.Status.AtProvider.ID = ""
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.ID = observed.ID
+	cr.Status.AtProvider.AccessKey = observed.Name
+	cr.Status.AtProvider.ClusterID = observed.ClusterID
+	cr.Status.AtProvider.ExpiresAt = nil
+	if observed.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, observed.ExpiresAt)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errParseExpiry)
+		}
+		cr.Status.AtProvider.ExpiresAt = &metav1.Time{Time: t}
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	// Tokens are immutable. A token that is expired, about to expire or
+	// differs from the spec is reported as missing so that Create replaces
+	// it.
+	clusterID, err := c.clusterID(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if observed.Expired || needsRenewal(cr) || observed.ClusterID != clusterID || !upToDate(cr, observed) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Token)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotToken)
+	}
+
+	clusterID, err := c.clusterID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	desired := v1alpha1.TokenData{
+		Description: cr.Spec.ForProvider.Description,
+		ClusterID:   clusterID,
+	}
+	if ttl := cr.Spec.ForProvider.TTL; ttl != nil {
+		desired.TTLMillis = ttl.Milliseconds()
+	}
+	created, err := util.CreateToken(c.rancherHost, c.token, c.httpClient, desired, ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// Only the external name is persisted after Create. The token it
+	// replaces, if any, is deleted once the new one has been created.
+	previous := meta.GetExternalName(cr)
+	meta.SetExternalName(cr, created.ID)
+	if previous != "" && previous != created.ID {
+		if err := util.DeleteToken(c.rancherHost, c.token, previous, c.httpClient, ctx); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	secretKey := created.Token
+	if i := strings.Index(created.Token, ":"); i >= 0 {
+		secretKey = created.Token[i+1:]
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			"token":     []byte(created.Token),
+			"accessKey": []byte(created.Name),
+			"secretKey": []byte(secretKey),
+		},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// Observe reports tokens that differ from the spec as missing, so there
+	// is never anything to update.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Token)
+	if !ok {
+		return errors.New(errNotToken)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return nil
+	}
+	return util.DeleteToken(c.rancherHost, c.token, id, c.httpClient, ctx)
+}
+
+// clusterID returns the ID of the cluster the token is scoped to, resolving
+// clusterIdRef if necessary. Unscoped tokens return an empty ID.
+func (c *external) clusterID(ctx context.Context, cr *v1alpha1.Token) (string, error) {
+	p := cr.Spec.ForProvider
+	if p.ClusterID != "" || p.ClusterIDRef == "" {
+		return p.ClusterID, nil
+	}
+	return c.lists.ClusterIDByName(ctx, c.listKey(), c.httpClient, p.ClusterIDRef)
+}
+
+// upToDate reports whether the description and TTL of the observed token are
+// those of the spec. The TTL is not compared when the spec sets none, since
+// Rancher may give such tokens a default TTL.
+func upToDate(cr *v1alpha1.Token, observed *v1alpha1.TokenData) bool {
+	p := cr.Spec.ForProvider
+	if observed.Description != p.Description {
+		return false
+	}
+	return p.TTL == nil || observed.TTLMillis == p.TTL.Milliseconds()
+}
+
+// needsRenewal reports whether the token expires within the renewal window.
+func needsRenewal(cr *v1alpha1.Token) bool {
+	p := cr.Spec.ForProvider
+	expiresAt := cr.Status.AtProvider.ExpiresAt
+	if expiresAt == nil || p.TTL == nil {
+		return false
+	}
+	renewBefore := p.TTL.Duration / 10
+	if p.RenewBefore != nil {
+		renewBefore = p.RenewBefore.Duration
+	}
+	return time.Until(expiresAt.Time) <= renewBefore
+}
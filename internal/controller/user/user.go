@@ -0,0 +1,321 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/controller/features"
+	"github.com/dormullor/provider-rancher/internal/controller/terminal"
+	"github.com/dormullor/provider-rancher/util"
+)
+
+const (
+	errNotUser      = "managed resource is not a User custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+	errCompare      = "cannot compare user with Rancher"
+	errGetPassword  = "cannot get user password"
+)
+
+// annotationPasswordSecretVersion records the version of the password secret
+// a user was created with. Create can only persist annotations, so the
+// version is copied into the status when the user is next observed.
+const annotationPasswordSecretVersion = "rancher.crossplane.io/password-secret-version"
+
+// Setup adds a controller that reconciles User managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.UserGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	q := terminal.New(o.PollInterval)
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.UserGroupVersionKind),
+		managed.WithExternalConnecter(q.Connecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{})})),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.User{}).
+		Complete(ratelimiter.NewReconciler(name, q.Reconciler(r), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return nil, errors.New(errNotUser)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	tokenDecoded, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	rancherHost := pc.Spec.RancherHost
+	token := "Basic " + b64.StdEncoding.EncodeToString(tokenDecoded)
+	client := util.NewHTTPClient(pc)
+	return &external{httpClient: *client, token: token, kube: c.kube, rancherHost: rancherHost}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	httpClient  http.Client
+	token       string
+	rancherHost string
+	kube        client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUser)
+	}
+
+	var observed *v1alpha1.UserData
+	var err error
+	if id := cr.Status.AtProvider.ID; id != "" {
+		observed, err = util.GetUser(c.rancherHost, c.token, id, c.httpClient, ctx)
+	} else {
+		observed, err = util.GetUserByUsername(c.rancherHost, c.token, username(cr), c.httpClient, ctx)
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if observed == nil {
+		cr.Status.AtProvider.ID = ""
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.ID = observed.ID
+	cr.Status.AtProvider.PrincipalIDs = observed.PrincipalIDs
+	if v, ok := cr.GetAnnotations()[annotationPasswordSecretVersion]; ok && cr.Status.AtProvider.PasswordSecretVersion == "" {
+		cr.Status.AtProvider.PasswordSecretVersion = v
+	}
+	cr.Status.SetConditions(xpv1.Available())
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	upToDate, err := util.IsSubset(desiredUser(cr), observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+	_, version, err := util.GetSecretValue(ctx, c.kube, cr.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPassword)
+	}
+	upToDate = upToDate && version == cr.Status.AtProvider.PasswordSecretVersion
+	if upToDate && cr.Spec.ForProvider.GlobalRoleIDs != nil {
+		missing, extra, err := c.globalRoleBindingDiff(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = len(missing) == 0 && len(extra) == 0
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUser)
+	}
+
+	password, version, err := util.GetSecretValue(ctx, c.kube, cr.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPassword)
+	}
+	desired := desiredUser(cr)
+	desired.Password = string(password)
+	id, err := util.CreateUser(c.rancherHost, c.token, c.httpClient, desired, ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cr.Status.AtProvider.ID = id
+	meta.AddAnnotations(cr, map[string]string{annotationPasswordSecretVersion: version})
+
+	if err := c.syncGlobalRoleBindings(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUser)
+	}
+
+	id := cr.Status.AtProvider.ID
+	if err := util.UpdateUser(c.rancherHost, c.token, id, c.httpClient, desiredUser(cr), ctx); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	password, version, err := util.GetSecretValue(ctx, c.kube, cr.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPassword)
+	}
+	if version != cr.Status.AtProvider.PasswordSecretVersion {
+		if err := util.SetUserPassword(c.rancherHost, c.token, id, string(password), c.httpClient, ctx); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		cr.Status.AtProvider.PasswordSecretVersion = version
+	}
+	if err := c.syncGlobalRoleBindings(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return errors.New(errNotUser)
+	}
+	return util.DeleteUser(c.rancherHost, c.token, cr.Status.AtProvider.ID, c.httpClient, ctx)
+}
+
+// globalRoleBindingDiff returns the desired global roles not yet bound to the
+// user and the IDs of bindings to global roles that are not desired.
+func (c *external) globalRoleBindingDiff(ctx context.Context, cr *v1alpha1.User) ([]string, []string, error) {
+	bindings, err := util.ListRoleBindings(c.rancherHost, c.token, util.GlobalRoleBindings, v1alpha1.RoleBindingData{UserID: cr.Status.AtProvider.ID}, c.httpClient, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	desired := map[string]bool{}
+	for _, id := range cr.Spec.ForProvider.GlobalRoleIDs {
+		desired[id] = true
+	}
+	bound := map[string]bool{}
+	extra := []string{}
+	for _, b := range bindings {
+		if desired[b.GlobalRoleID] && !bound[b.GlobalRoleID] {
+			bound[b.GlobalRoleID] = true
+			continue
+		}
+		extra = append(extra, b.ID)
+	}
+	missing := []string{}
+	for _, id := range cr.Spec.ForProvider.GlobalRoleIDs {
+		if !bound[id] {
+			missing = append(missing, id)
+			bound[id] = true
+		}
+	}
+	return missing, extra, nil
+}
+
+// syncGlobalRoleBindings binds the desired global roles to the user and
+// removes bindings to any other global role. Nothing is done when no global
+// roles are set.
+func (c *external) syncGlobalRoleBindings(ctx context.Context, cr *v1alpha1.User) error {
+	if cr.Spec.ForProvider.GlobalRoleIDs == nil {
+		return nil
+	}
+	missing, extra, err := c.globalRoleBindingDiff(ctx, cr)
+	if err != nil {
+		return err
+	}
+	for _, roleID := range missing {
+		binding := v1alpha1.RoleBindingData{GlobalRoleID: roleID, UserID: cr.Status.AtProvider.ID}
+		if _, err := util.CreateRoleBinding(c.rancherHost, c.token, util.GlobalRoleBindings, c.httpClient, binding, ctx); err != nil {
+			return err
+		}
+	}
+	for _, id := range extra {
+		if err := util.DeleteRoleBinding(c.rancherHost, c.token, util.GlobalRoleBindings, id, c.httpClient, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func username(cr *v1alpha1.User) string {
+	if cr.Spec.ForProvider.Username != "" {
+		return cr.Spec.ForProvider.Username
+	}
+	return cr.Name
+}
+
+func desiredUser(cr *v1alpha1.User) v1alpha1.UserData {
+	p := cr.Spec.ForProvider
+	enabled := true
+	if p.Enabled != nil {
+		enabled = *p.Enabled
+	}
+	return v1alpha1.UserData{
+		Username:           username(cr),
+		Name:               p.DisplayName,
+		Description:        p.Description,
+		MustChangePassword: p.MustChangePassword,
+		Enabled:            &enabled,
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines the Prometheus metrics of the provider. They are
+// registered on the controller-runtime registry, which the manager serves.
+package metrics
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "rancher"
+
+// codeNone is the status code label of requests that failed without a
+// response, e.g. because Rancher could not be reached.
+const codeNone = "none"
+
+var (
+	requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "client",
+		Name:      "requests_total",
+		Help:      "Number of requests sent to the Rancher API.",
+	}, []string{"method", "endpoint", "code"})
+
+	requestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "client",
+		Name:      "request_errors_total",
+		Help:      "Number of requests to the Rancher API that failed or returned an error status.",
+	}, []string{"method", "endpoint", "code"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "client",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests to the Rancher API.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "endpoint", "code"})
+
+	clusterState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "cluster",
+		Name:      "state",
+		Help:      "State of a managed cluster in Rancher, e.g. active, provisioning or error. The series of the current state is 1.",
+	}, []string{"kind", "name", "state"})
+
+	nodePoolNodes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "cluster",
+		Name:      "node_pool_nodes",
+		Help:      "Number of nodes of a node pool of a managed cluster.",
+	}, []string{"kind", "name", "pool"})
+
+	kubeconfigs = &kubeconfigAges{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cluster", "kubeconfig_age_seconds"),
+			"Time since the kubeconfig of a managed cluster was generated.",
+			[]string{"kind", "name"}, nil),
+		generated: map[clusterKey]time.Time{},
+	}
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(requests, requestErrors, requestDuration, clusterState, nodePoolNodes, kubeconfigs)
+}
+
+// ObserveRequest records a request to the Rancher API. endpoint is the path
+// of the request with its identifiers replaced, so that it has a bounded
+// number of values. code is the status code of the response, or 0 if the
+// request failed without one.
+func ObserveRequest(method, endpoint string, code int, duration time.Duration) {
+	c := codeNone
+	if code != 0 {
+		c = strconv.Itoa(code)
+	}
+	requests.WithLabelValues(method, endpoint, c).Inc()
+	requestDuration.WithLabelValues(method, endpoint, c).Observe(duration.Seconds())
+	if code == 0 || code >= 400 {
+		requestErrors.WithLabelValues(method, endpoint, c).Inc()
+	}
+}
+
+// SetClusterState records the state Rancher reports for a managed cluster of
+// the supplied kind.
+func SetClusterState(kind, name, state string) {
+	clusterState.DeletePartialMatch(prometheus.Labels{"kind": kind, "name": name})
+	clusterState.WithLabelValues(kind, name, state).Set(1)
+}
+
+// SetNodePoolNodes records the number of nodes per node pool of a managed
+// cluster. Pools missing from nodes are no longer reported.
+func SetNodePoolNodes(kind, name string, nodes map[string]int) {
+	nodePoolNodes.DeletePartialMatch(prometheus.Labels{"kind": kind, "name": name})
+	for pool, n := range nodes {
+		nodePoolNodes.WithLabelValues(kind, name, pool).Set(float64(n))
+	}
+}
+
+// SetKubeconfigGenerated records when the kubeconfig of a managed cluster was
+// last generated.
+func SetKubeconfigGenerated(kind, name string, t time.Time) {
+	kubeconfigs.set(clusterKey{kind: kind, name: name}, t)
+}
+
+// ForgetCluster stops reporting a managed cluster that no longer exists.
+func ForgetCluster(kind, name string) {
+	l := prometheus.Labels{"kind": kind, "name": name}
+	clusterState.DeletePartialMatch(l)
+	nodePoolNodes.DeletePartialMatch(l)
+	kubeconfigs.delete(clusterKey{kind: kind, name: name})
+}
+
+type clusterKey struct {
+	kind string
+	name string
+}
+
+// kubeconfigAges reports the age of kubeconfigs when they are collected,
+// rather than when their clusters were last observed.
+type kubeconfigAges struct {
+	desc *prometheus.Desc
+
+	mu        sync.Mutex
+	generated map[clusterKey]time.Time
+}
+
+func (k *kubeconfigAges) set(key clusterKey, t time.Time) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.generated[key] = t
+}
+
+func (k *kubeconfigAges) delete(key clusterKey) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	delete(k.generated, key)
+}
+
+// Describe implements prometheus.Collector.
+func (k *kubeconfigAges) Describe(ch chan<- *prometheus.Desc) {
+	ch <- k.desc
+}
+
+// Collect implements prometheus.Collector.
+func (k *kubeconfigAges) Collect(ch chan<- prometheus.Metric) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	for key, t := range k.generated {
+		ch <- prometheus.MustNewConstMetric(k.desc, prometheus.GaugeValue, time.Since(t).Seconds(), key.kind, key.name)
+	}
+}
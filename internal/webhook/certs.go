@@ -0,0 +1,244 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CertFile and KeyFile are the names of the serving certificate and key
+	// in the certificate directory, as expected by the webhook server.
+	CertFile = "tls.crt"
+	KeyFile  = "tls.key"
+
+	// suffix ends the names of all webhooks and API groups of the provider.
+	suffix = ".rancher.crossplane.io"
+
+	certValidity = 10 * 365 * 24 * time.Hour
+
+	errGenerateKey  = "cannot generate private key"
+	errCreateCert   = "cannot create certificate"
+	errWriteCert    = "cannot write certificate"
+	errListWebhooks = "cannot list webhook configurations"
+	errListCRDs     = "cannot list CustomResourceDefinitions"
+	errInjectCA     = "cannot inject CA bundle"
+)
+
+// EnsureCertificate makes sure the certificate directory contains a serving
+// certificate. Certificates provided by Crossplane or mounted from a secret
+// are used as they are and nil is returned. Otherwise a self-signed
+// certificate for the supplied DNS names is generated and its PEM encoding is
+// returned, to be injected as CA bundle of the webhook configurations.
+func EnsureCertificate(dir string, dnsNames ...string) ([]byte, error) {
+	certPath, keyPath := filepath.Join(dir, CertFile), filepath.Join(dir, KeyFile)
+	if exists(certPath) && exists(keyPath) {
+		return nil, nil
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, errGenerateKey)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, errGenerateKey)
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:              dnsNames,
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateCert)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateCert)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Wrap(err, errWriteCert)
+	}
+	if err := os.WriteFile(certPath, certPEM, 0o600); err != nil {
+		return nil, errors.Wrap(err, errWriteCert)
+	}
+	if err := os.WriteFile(keyPath, keyPEM, 0o600); err != nil {
+		return nil, errors.Wrap(err, errWriteCert)
+	}
+	return certPEM, nil
+}
+
+// A Service is the service the webhook server of the provider is reached
+// through.
+type Service struct {
+	Name      string
+	Namespace string
+	Port      int32
+}
+
+// InjectClientConfig points all webhooks of the provider in the validating and
+// mutating webhook configurations of the cluster, and the conversion webhooks
+// of its CRDs, at the supplied service and sets their CA bundle.
+func InjectClientConfig(ctx context.Context, cfg *rest.Config, svc Service, ca []byte) error {
+	kube, err := newClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	vl := &admissionv1.ValidatingWebhookConfigurationList{}
+	if err := kube.List(ctx, vl); err != nil {
+		return errors.Wrap(err, errListWebhooks)
+	}
+	for i := range vl.Items {
+		wc := &vl.Items[i]
+		changed := false
+		for j := range wc.Webhooks {
+			changed = setClientConfig(wc.Webhooks[j].Name, &wc.Webhooks[j].ClientConfig, svc, ca) || changed
+		}
+		if changed {
+			if err := kube.Update(ctx, wc); err != nil {
+				return errors.Wrap(err, errInjectCA)
+			}
+		}
+	}
+
+	ml := &admissionv1.MutatingWebhookConfigurationList{}
+	if err := kube.List(ctx, ml); err != nil {
+		return errors.Wrap(err, errListWebhooks)
+	}
+	for i := range ml.Items {
+		wc := &ml.Items[i]
+		changed := false
+		for j := range wc.Webhooks {
+			changed = setClientConfig(wc.Webhooks[j].Name, &wc.Webhooks[j].ClientConfig, svc, ca) || changed
+		}
+		if changed {
+			if err := kube.Update(ctx, wc); err != nil {
+				return errors.Wrap(err, errInjectCA)
+			}
+		}
+	}
+
+	return injectConversion(ctx, kube, svc, ca)
+}
+
+// InjectConversionClientConfig points the conversion webhooks of the CRDs of
+// the provider at the supplied service and sets their CA bundle. It is used
+// when only conversion is served.
+func InjectConversionClientConfig(ctx context.Context, cfg *rest.Config, svc Service, ca []byte) error {
+	kube, err := newClient(cfg)
+	if err != nil {
+		return err
+	}
+	return injectConversion(ctx, kube, svc, ca)
+}
+
+func newClient(cfg *rest.Config) (client.Client, error) {
+	s := runtime.NewScheme()
+	if err := admissionv1.AddToScheme(s); err != nil {
+		return nil, errors.Wrap(err, errInjectCA)
+	}
+	if err := extv1.AddToScheme(s); err != nil {
+		return nil, errors.Wrap(err, errInjectCA)
+	}
+	kube, err := client.New(cfg, client.Options{Scheme: s})
+	return kube, errors.Wrap(err, errInjectCA)
+}
+
+func injectConversion(ctx context.Context, kube client.Client, svc Service, ca []byte) error {
+	crds := &extv1.CustomResourceDefinitionList{}
+	if err := kube.List(ctx, crds); err != nil {
+		return errors.Wrap(err, errListCRDs)
+	}
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		conv := crd.Spec.Conversion
+		if !strings.HasSuffix(crd.Spec.Group, suffix) || conv == nil || conv.Strategy != extv1.WebhookConverter || conv.Webhook == nil || conv.Webhook.ClientConfig == nil {
+			continue
+		}
+		cc := conv.Webhook.ClientConfig
+		path := "/convert"
+		if cc.Service != nil && cc.Service.Path != nil {
+			path = *cc.Service.Path
+		}
+		desired := &extv1.ServiceReference{Name: svc.Name, Namespace: svc.Namespace, Path: &path, Port: &svc.Port}
+		if bytes.Equal(cc.CABundle, ca) && cc.URL == nil && equality.Semantic.DeepEqual(cc.Service, desired) {
+			continue
+		}
+		cc.URL = nil
+		cc.Service = desired
+		cc.CABundle = ca
+		if err := kube.Update(ctx, crd); err != nil {
+			return errors.Wrap(err, errInjectCA)
+		}
+	}
+	return nil
+}
+
+func setClientConfig(name string, cc *admissionv1.WebhookClientConfig, svc Service, ca []byte) bool {
+	if !strings.HasSuffix(name, suffix) {
+		return false
+	}
+	var path *string
+	if cc.Service != nil {
+		path = cc.Service.Path
+	}
+	desired := &admissionv1.ServiceReference{Name: svc.Name, Namespace: svc.Namespace, Path: path, Port: &svc.Port}
+	if bytes.Equal(cc.CABundle, ca) && cc.URL == nil && equality.Semantic.DeepEqual(cc.Service, desired) {
+		return false
+	}
+	cc.URL = nil
+	cc.Service = desired
+	cc.CABundle = ca
+	return true
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
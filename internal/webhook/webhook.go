@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook serves the admission and conversion webhooks of the
+// provider.
+package webhook
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+)
+
+// Setup registers the admission webhooks of all resources with the webhook
+// server of the manager.
+func Setup(mgr ctrl.Manager) error {
+	for _, setup := range []func(ctrl.Manager) error{
+		(&v1alpha1.RKE1Cluster{}).SetupWebhookWithManager,
+		(&v1alpha1.RKE1NodeTemplate{}).SetupWebhookWithManager,
+	} {
+		if err := setup(mgr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetupConversion registers the conversion webhook of the resources served in
+// several API versions with the webhook server of the manager. Setup
+// registers it as well, along with the admission webhooks.
+func SetupConversion(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register("/convert", &conversion.Webhook{})
+	return nil
+}
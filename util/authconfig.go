@@ -0,0 +1,75 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Paths of the authentication provider configurations below /v3.
+const (
+	GitHubAuthConfig          = "githubConfigs/github"
+	ActiveDirectoryAuthConfig = "activeDirectoryConfigs/activedirectory"
+	OpenLDAPAuthConfig        = "openLdapConfigs/openldap"
+	KeyCloakOIDCAuthConfig    = "keyCloakOIDCConfigs/keycloakoidc"
+)
+
+var samlAuthConfigs = map[string]string{
+	"keycloak":   "keyCloakConfigs/keycloak",
+	"ping":       "pingConfigs/ping",
+	"adfs":       "adfsConfigs/adfs",
+	"okta":       "oktaConfigs/okta",
+	"shibboleth": "shibbolethConfigs/shibboleth",
+}
+
+// SecretVersionAnnotation records the version of the secrets an
+// authentication provider was configured with. Only annotations are persisted
+// after Create, so controllers read the version from it until it has been
+// recorded in the status.
+const SecretVersionAnnotation = "rancher.crossplane.io/secret-version"
+
+// SAMLAuthConfig returns the path of the configuration of the supplied SAML
+// provider.
+func SAMLAuthConfig(provider string) (string, error) {
+	path, ok := samlAuthConfigs[provider]
+	if !ok {
+		return "", fmt.Errorf("unknown SAML provider %q", provider)
+	}
+	return path, nil
+}
+
+// GetAuthConfig decodes the authentication provider configuration at the
+// supplied path into out.
+func GetAuthConfig(host, token, path string, out interface{}, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/%s", host, path)
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, out, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to get auth config: %w", err)
+	}
+	return nil
+}
+
+// UpdateAuthConfig replaces the authentication provider configuration at the
+// supplied path.
+func UpdateAuthConfig(host, token, path string, in interface{}, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/%s", host, path)
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, in, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update auth config: %w", err)
+	}
+	return nil
+}
+
+// AuthConfigAction runs an action, e.g. configureTest or testAndApply, of the
+// authentication provider configuration at the supplied path.
+func AuthConfigAction(host, token, path, action string, in, out interface{}, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/%s?action=%s", host, path, action)
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, in, out); err != nil {
+		return fmt.Errorf("failed to run auth config action %s: %w", action, err)
+	}
+	return nil
+}
+
+// DisableAuthConfig disables the authentication provider configuration at
+// the supplied path.
+func DisableAuthConfig(host, token, path string, httpClient http.Client, ctx context.Context) error {
+	return AuthConfigAction(host, token, path, "disable", nil, nil, httpClient, ctx)
+}
@@ -0,0 +1,109 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	catalogv1alpha1 "github.com/dormullor/provider-rancher/apis/catalog/v1alpha1"
+)
+
+const clusterRepoType = "catalog.cattle.io.clusterrepo"
+
+func catalogURL(host, clusterID, collection string) string {
+	return fmt.Sprintf("%s/k8s/clusters/%s/v1/catalog.cattle.io.%s", host, clusterID, collection)
+}
+
+// GetClusterRepo returns the catalog repository with the supplied name of a
+// cluster, or nil if it does not exist.
+func GetClusterRepo(host, token, clusterID, name string, httpClient http.Client, ctx context.Context) (*catalogv1alpha1.ClusterRepoData, error) {
+	u := catalogURL(host, clusterID, "clusterrepos") + "/" + name
+	result := &catalogv1alpha1.ClusterRepoData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster repo: %w", err)
+	}
+	return result, nil
+}
+
+// CreateClusterRepo adds a catalog repository to a cluster.
+func CreateClusterRepo(host, token, clusterID, name string, spec catalogv1alpha1.ClusterRepoSpecData, httpClient http.Client, ctx context.Context) error {
+	u := catalogURL(host, clusterID, "clusterrepos")
+	repo := catalogv1alpha1.ClusterRepoData{
+		Type:     clusterRepoType,
+		Metadata: catalogv1alpha1.SteveMetadata{Name: name},
+		Spec:     spec,
+	}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, repo, nil, http.StatusCreated); err != nil {
+		return fmt.Errorf("failed to create cluster repo: %w", err)
+	}
+	return nil
+}
+
+// UpdateClusterRepo replaces the spec of an existing catalog repository.
+func UpdateClusterRepo(host, token, clusterID string, repo catalogv1alpha1.ClusterRepoData, httpClient http.Client, ctx context.Context) error {
+	u := catalogURL(host, clusterID, "clusterrepos") + "/" + repo.Metadata.Name
+	repo.Type = clusterRepoType
+	repo.Status = nil
+	repo.Metadata.State = nil
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, repo, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update cluster repo: %w", err)
+	}
+	return nil
+}
+
+// DeleteClusterRepo removes a catalog repository from a cluster.
+func DeleteClusterRepo(host, token, clusterID, name string, httpClient http.Client, ctx context.Context) error {
+	u := catalogURL(host, clusterID, "clusterrepos") + "/" + name
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete cluster repo: %w", err)
+	}
+	return nil
+}
+
+// GetApp returns the installed Helm release with the supplied namespace and
+// name, or nil if it does not exist.
+func GetApp(host, token, clusterID, namespace, name string, httpClient http.Client, ctx context.Context) (*catalogv1alpha1.AppData, error) {
+	u := fmt.Sprintf("%s/%s/%s", catalogURL(host, clusterID, "apps"), namespace, name)
+	result := &catalogv1alpha1.AppData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app: %w", err)
+	}
+	return result, nil
+}
+
+// InstallChart starts the installation of charts from a catalog repository.
+// The installation runs asynchronously in the cluster.
+func InstallChart(host, token, clusterID, repo string, input catalogv1alpha1.ChartInstallAction, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/%s?action=install", catalogURL(host, clusterID, "clusterrepos"), repo)
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, input, nil); err != nil {
+		return fmt.Errorf("failed to install chart: %w", err)
+	}
+	return nil
+}
+
+// UpgradeChart starts the upgrade of releases from a catalog repository. The
+// upgrade runs asynchronously in the cluster.
+func UpgradeChart(host, token, clusterID, repo string, input catalogv1alpha1.ChartUpgradeAction, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/%s?action=upgrade", catalogURL(host, clusterID, "clusterrepos"), repo)
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, input, nil); err != nil {
+		return fmt.Errorf("failed to upgrade chart: %w", err)
+	}
+	return nil
+}
+
+// UninstallApp starts the uninstallation of a Helm release.
+func UninstallApp(host, token, clusterID, namespace, name string, input catalogv1alpha1.ChartUninstallAction, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/%s/%s?action=uninstall", catalogURL(host, clusterID, "apps"), namespace, name)
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, input, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to uninstall app: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+)
+
+// Settings listing the RKE Kubernetes versions Rancher can deploy. Rancher
+// derives them from the metadata configured in rke-metadata-config.
+const (
+	settingK8sVersionsCurrent    = "k8s-versions-current"
+	settingK8sVersionsDeprecated = "k8s-versions-deprecated"
+)
+
+// settingSystemNamespaces lists the namespaces Rancher and Kubernetes run
+// their own workloads in.
+const settingSystemNamespaces = "system-namespaces"
+
+// GetCluster returns the cluster with the supplied ID, or nil if it does not
+// exist.
+func GetCluster(host, token, id string, httpClient http.Client, ctx context.Context) (*v1alpha1.Data, error) {
+	u := fmt.Sprintf("%s/v3/clusters/%s", host, id)
+	result := &v1alpha1.Data{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+	return result, nil
+}
+
+// ClusterAction runs an action, e.g. backupEtcd, of the cluster with the
+// supplied ID. The response is decoded into out when out is not nil.
+func ClusterAction(host, token, id, action string, in, out interface{}, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/clusters/%s?action=%s", host, id, action)
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, in, out); err != nil {
+		return fmt.Errorf("failed to run cluster action %s: %w", action, err)
+	}
+	return nil
+}
+
+// RotateCertificates rotates the certificates of the cluster with the supplied
+// ID.
+func RotateCertificates(host, token, id string, input v1alpha1.RotateCertificateInput, httpClient http.Client, ctx context.Context) error {
+	return ClusterAction(host, token, id, "rotateCertificates", input, nil, httpClient, ctx)
+}
+
+// UpgradeCluster sets the Kubernetes version and upgrade strategy of the RKE
+// configuration of the cluster with the supplied ID, leaving the rest of the
+// cluster unchanged. A nil strategy keeps the current one.
+func UpgradeCluster(host, token, id, kubernetesVersion string, strategy *v1alpha1.NodeUpgradeStrategy, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/clusters/%s", host, id)
+	cluster := map[string]interface{}{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, &cluster, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to get cluster: %w", err)
+	}
+	rke, ok := cluster["rancherKubernetesEngineConfig"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("failed to upgrade cluster: cluster %s is not a RKE1 cluster", id)
+	}
+	rke["kubernetesVersion"] = kubernetesVersion
+	if strategy != nil {
+		rke["upgradeStrategy"] = strategy
+	}
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, cluster, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to upgrade cluster: %w", err)
+	}
+	return nil
+}
+
+// GetKubernetesVersions returns the RKE Kubernetes versions, e.g.
+// v1.24.6-rancher1-1, Rancher can deploy.
+func GetKubernetesVersions(host, token string, httpClient http.Client, ctx context.Context) ([]string, error) {
+	var versions []string
+	for _, name := range []string{settingK8sVersionsCurrent, settingK8sVersionsDeprecated} {
+		setting, err := GetSetting(host, token, name, httpClient, ctx)
+		if err != nil {
+			return nil, err
+		}
+		if setting == nil {
+			continue
+		}
+		for _, v := range strings.Split(setting.Value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				versions = append(versions, v)
+			}
+		}
+	}
+	return versions, nil
+}
+
+// CompareKubernetesVersions compares the Kubernetes releases of two RKE
+// Kubernetes versions, ignoring their Rancher suffix. It returns -1, 0 or 1
+// when a is older than, the same release as or newer than b.
+func CompareKubernetesVersions(a, b string) (int, error) {
+	va, err := version.ParseGeneric(KubeletVersion(a))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse kubernetes version %s: %w", a, err)
+	}
+	return va.Compare(KubeletVersion(b))
+}
+
+// KubeletVersion returns the version reported by the kubelets of a RKE
+// Kubernetes version, e.g. v1.24.6 for v1.24.6-rancher1-1.
+func KubeletVersion(v string) string {
+	if i := strings.Index(v, "-rancher"); i >= 0 {
+		return v[:i]
+	}
+	return v
+}
+
+// ListWorkloadNamespaces returns the namespaces of the cluster with the
+// supplied ID that have running pods, ignoring the system namespaces of
+// Rancher and Kubernetes. The pods are listed through the Rancher proxy of the
+// cluster API, so the cluster has to be reachable.
+func ListWorkloadNamespaces(host, token, clusterID string, httpClient http.Client, ctx context.Context) ([]string, error) {
+	system := map[string]bool{}
+	setting, err := GetSetting(host, token, settingSystemNamespaces, httpClient, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if setting != nil {
+		value := setting.Value
+		if value == "" {
+			value = setting.Default
+		}
+		for _, ns := range strings.Split(value, ",") {
+			system[strings.TrimSpace(ns)] = true
+		}
+	}
+
+	q := url.Values{"fieldSelector": {"status.phase=Running"}}
+	u := fmt.Sprintf("%s/k8s/clusters/%s/api/v1/pods?%s", host, clusterID, q.Encode())
+	pods := &corev1.PodList{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, pods, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	seen := map[string]bool{}
+	var namespaces []string
+	for _, p := range pods.Items {
+		ns := p.Namespace
+		if seen[ns] || system[ns] || strings.HasPrefix(ns, "cattle-") {
+			continue
+		}
+		seen[ns] = true
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
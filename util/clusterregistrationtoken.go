@@ -0,0 +1,49 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+)
+
+// GetClusterRegistrationToken returns the registration token of the cluster
+// with the supplied ID, preferring the default token Rancher creates for every
+// cluster. It returns nil if the cluster has no token yet. The token and the
+// node commands of a token that was just created may still be empty.
+func GetClusterRegistrationToken(host, token, clusterID string, httpClient http.Client, ctx context.Context) (*v1alpha1.ClusterRegistrationTokenData, error) {
+	q := url.Values{"clusterId": {clusterID}}
+	u := fmt.Sprintf("%s/v3/clusterregistrationtokens?%s", host, q.Encode())
+	result := &v1alpha1.ClusterRegistrationTokenResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to get cluster registration token: %w", err)
+	}
+	var found *v1alpha1.ClusterRegistrationTokenData
+	for i := range result.Data {
+		t := &result.Data[i]
+		if t.ClusterID != clusterID {
+			continue
+		}
+		if t.Name == "default-token" {
+			return t, nil
+		}
+		if found == nil {
+			found = t
+		}
+	}
+	return found, nil
+}
+
+// CreateClusterRegistrationToken creates a registration token for the
+// cluster with the supplied ID. Rancher fills in the token and the node
+// commands asynchronously.
+func CreateClusterRegistrationToken(host, token, clusterID string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/clusterregistrationtokens", host)
+	in := v1alpha1.ClusterRegistrationTokenData{ClusterID: clusterID}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, in, nil, http.StatusCreated); err != nil {
+		return fmt.Errorf("failed to create cluster registration token: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+)
+
+// GetClusterTemplate returns the cluster template with the supplied ID, or
+// nil if it does not exist.
+func GetClusterTemplate(host, token, id string, httpClient http.Client, ctx context.Context) (*v1alpha1.ClusterTemplateData, error) {
+	u := fmt.Sprintf("%s/v3/clustertemplates/%s", host, id)
+	result := &v1alpha1.ClusterTemplateData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster template: %w", err)
+	}
+	return result, nil
+}
+
+// GetClusterTemplateByName returns the cluster template with the supplied
+// name, or nil if it does not exist.
+func GetClusterTemplateByName(host, token, name string, httpClient http.Client, ctx context.Context) (*v1alpha1.ClusterTemplateData, error) {
+	u := fmt.Sprintf("%s/v3/clustertemplates?name=%s", host, url.QueryEscape(name))
+	result := &v1alpha1.ClusterTemplateResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to get cluster template: %w", err)
+	}
+	for i := range result.Data {
+		if result.Data[i].Name == name {
+			return &result.Data[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateClusterTemplate creates a cluster template and returns its ID.
+func CreateClusterTemplate(host, token string, httpClient http.Client, ct v1alpha1.ClusterTemplateData, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/clustertemplates", host)
+	result := &v1alpha1.ClusterTemplateData{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, ct, result, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("failed to create cluster template: %w", err)
+	}
+	return result.ID, nil
+}
+
+// UpdateClusterTemplate updates the cluster template with the supplied ID.
+func UpdateClusterTemplate(host, token, id string, httpClient http.Client, ct v1alpha1.ClusterTemplateData, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/clustertemplates/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, ct, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update cluster template: %w", err)
+	}
+	return nil
+}
+
+// DeleteClusterTemplate deletes the cluster template with the supplied ID.
+func DeleteClusterTemplate(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/clustertemplates/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete cluster template: %w", err)
+	}
+	return nil
+}
+
+// GetClusterTemplateRevision returns the cluster template revision with the
+// supplied ID, or nil if it does not exist.
+func GetClusterTemplateRevision(host, token, id string, httpClient http.Client, ctx context.Context) (*v1alpha1.ClusterTemplateRevisionData, error) {
+	u := fmt.Sprintf("%s/v3/clustertemplaterevisions/%s", host, id)
+	result := &v1alpha1.ClusterTemplateRevisionData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster template revision: %w", err)
+	}
+	return result, nil
+}
+
+// GetClusterTemplateRevisionByName returns the revision with the supplied
+// name of a cluster template, or nil if it does not exist.
+func GetClusterTemplateRevisionByName(host, token, templateID, name string, httpClient http.Client, ctx context.Context) (*v1alpha1.ClusterTemplateRevisionData, error) {
+	q := url.Values{"name": {name}, "clusterTemplateId": {templateID}}
+	u := fmt.Sprintf("%s/v3/clustertemplaterevisions?%s", host, q.Encode())
+	result := &v1alpha1.ClusterTemplateRevisionResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to get cluster template revision: %w", err)
+	}
+	for i := range result.Data {
+		if result.Data[i].Name == name && result.Data[i].ClusterTemplateID == templateID {
+			return &result.Data[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateClusterTemplateRevision creates a cluster template revision and
+// returns its ID.
+func CreateClusterTemplateRevision(host, token string, httpClient http.Client, rev v1alpha1.ClusterTemplateRevisionData, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/clustertemplaterevisions", host)
+	result := &v1alpha1.ClusterTemplateRevisionData{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, rev, result, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("failed to create cluster template revision: %w", err)
+	}
+	return result.ID, nil
+}
+
+// UpdateClusterTemplateRevision updates the cluster template revision with
+// the supplied ID. Rancher only allows changing whether it is enabled.
+func UpdateClusterTemplateRevision(host, token, id string, httpClient http.Client, rev v1alpha1.ClusterTemplateRevisionData, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/clustertemplaterevisions/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, rev, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update cluster template revision: %w", err)
+	}
+	return nil
+}
+
+// DeleteClusterTemplateRevision deletes the cluster template revision with
+// the supplied ID.
+func DeleteClusterTemplateRevision(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/clustertemplaterevisions/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete cluster template revision: %w", err)
+	}
+	return nil
+}
+
+// CreateClusterFromTemplate creates a cluster from a cluster template
+// revision and returns its ID.
+func CreateClusterFromTemplate(host, token string, httpClient http.Client, cluster v1alpha1.ClusterFromTemplateRequest, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/clusters", host)
+	result := &v1alpha1.Data{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, cluster, result, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("failed to create cluster: %w", err)
+	}
+	return result.ID, nil
+}
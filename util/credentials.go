@@ -0,0 +1,91 @@
+package util
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// credential is a sensitive field of a Rancher request that may either be set
+// inline or read from a Kubernetes Secret.
+type credential struct {
+	value *string
+	ref   **xpv1.SecretKeySelector
+}
+
+// rkeCredentials returns the credentials of an RKE configuration.
+func rkeCredentials(rke *v1alpha1.RancherKubernetesEngineConfig) []credential {
+	creds := []credential{{&rke.BastionHost.SSHKey, &rke.BastionHost.SSHKeySecretRef}}
+	for i := range rke.Nodes {
+		n := &rke.Nodes[i]
+		creds = append(creds, credential{&n.SSHKey, &n.SSHKeySecretRef})
+	}
+	for i := range rke.PrivateRegistries {
+		r := &rke.PrivateRegistries[i]
+		creds = append(creds, credential{&r.Password, &r.PasswordSecretRef})
+	}
+	if b := rke.Services.Etcd.BackupConfig; b != nil && b.S3BackupConfig != nil {
+		creds = append(creds, credential{&b.S3BackupConfig.SecretKey, &b.S3BackupConfig.SecretKeySecretRef})
+	}
+	if w := rke.Network.WeaveNetworkProvider; w != nil {
+		creds = append(creds, credential{&w.Password, &w.PasswordSecretRef})
+	}
+	if a := rke.Network.AciNetworkProvider; a != nil {
+		creds = append(creds, credential{&a.Token, &a.TokenSecretRef})
+	}
+	return creds
+}
+
+// nodeTemplateCredentials returns the credentials of a node template.
+func nodeTemplateCredentials(p *v1alpha1.RKE1NodeTemplateParameters) []credential {
+	c := &p.Amazonec2Config
+	return []credential{
+		{&c.SessionToken, &c.SessionTokenSecretRef},
+		{&c.SSHKeyContents, &c.SSHKeyContentsSecretRef},
+	}
+}
+
+// resolveCredentials replaces every Secret reference with the value it
+// selects.
+func resolveCredentials(ctx context.Context, kubeClient client.Client, creds []credential) error {
+	for _, c := range creds {
+		if *c.ref == nil {
+			continue
+		}
+		value, _, err := GetSecretValue(ctx, kubeClient, **c.ref)
+		if err != nil {
+			return fmt.Errorf("failed to resolve secret reference: %w", err)
+		}
+		*c.value = string(value)
+		*c.ref = nil
+	}
+	return nil
+}
+
+// ResolveRKECredentials replaces the Secret references of an RKE
+// configuration with the values they select, so that the configuration can be
+// sent to Rancher. It must only be called on a copy of a managed resource's
+// spec, never on the spec itself, to keep credentials out of the resource.
+func ResolveRKECredentials(ctx context.Context, kubeClient client.Client, rke *v1alpha1.RancherKubernetesEngineConfig) error {
+	return resolveCredentials(ctx, kubeClient, rkeCredentials(rke))
+}
+
+// ResolveNodeTemplateCredentials replaces the Secret references of node
+// template parameters with the values they select. Like
+// ResolveRKECredentials it must only be called on a copy.
+func ResolveNodeTemplateCredentials(ctx context.Context, kubeClient client.Client, p *v1alpha1.RKE1NodeTemplateParameters) error {
+	return resolveCredentials(ctx, kubeClient, nodeTemplateCredentials(p))
+}
+
+// ClearRKECredentials removes all credentials and Secret references from an
+// RKE configuration. Rancher never returns credentials, so they have to be
+// ignored when comparing a desired configuration with an observed one.
+func ClearRKECredentials(rke *v1alpha1.RancherKubernetesEngineConfig) {
+	for _, c := range rkeCredentials(rke) {
+		*c.value = ""
+		*c.ref = nil
+	}
+}
@@ -0,0 +1,61 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+)
+
+// BackupEtcd takes an etcd snapshot of the cluster with the supplied ID and
+// returns the etcd backup tracking it.
+func BackupEtcd(host, token, clusterID string, httpClient http.Client, ctx context.Context) (*v1alpha1.EtcdBackupData, error) {
+	result := &v1alpha1.EtcdBackupData{}
+	if err := ClusterAction(host, token, clusterID, "backupEtcd", nil, result, httpClient, ctx); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RestoreFromEtcdBackup restores the cluster with the supplied ID from an
+// etcd backup.
+func RestoreFromEtcdBackup(host, token, clusterID string, input v1alpha1.RestoreFromEtcdBackupInput, httpClient http.Client, ctx context.Context) error {
+	return ClusterAction(host, token, clusterID, "restoreFromEtcdBackup", input, nil, httpClient, ctx)
+}
+
+// GetEtcdBackup returns the etcd backup with the supplied ID, or nil if it
+// does not exist.
+func GetEtcdBackup(host, token, id string, httpClient http.Client, ctx context.Context) (*v1alpha1.EtcdBackupData, error) {
+	u := fmt.Sprintf("%s/v3/etcdbackups/%s", host, id)
+	result := &v1alpha1.EtcdBackupData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get etcd backup: %w", err)
+	}
+	return result, nil
+}
+
+// ListEtcdBackups returns the etcd backups of the cluster with the supplied
+// ID.
+func ListEtcdBackups(host, token, clusterID string, httpClient http.Client, ctx context.Context) ([]v1alpha1.EtcdBackupData, error) {
+	u := fmt.Sprintf("%s/v3/etcdbackups?clusterId=%s", host, clusterID)
+	result := &v1alpha1.EtcdBackupResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to list etcd backups: %w", err)
+	}
+	return result.Data, nil
+}
+
+// DeleteEtcdBackup deletes the etcd backup with the supplied ID together with
+// its snapshot.
+func DeleteEtcdBackup(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/etcdbackups/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete etcd backup: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+
+	fleetv1alpha1 "github.com/dormullor/provider-rancher/apis/fleet/v1alpha1"
+)
+
+// Rancher v1 API collections of Fleet objects.
+const (
+	FleetGitRepos      = "fleet.cattle.io.gitrepos"
+	FleetClusterGroups = "fleet.cattle.io.clustergroups"
+	secrets            = "secrets"
+)
+
+// GetFleetObject decodes the object with the supplied namespace and name of a
+// collection of the local cluster into out. It returns false if the object
+// does not exist.
+func GetFleetObject(host, token, collection, namespace, name string, out interface{}, httpClient http.Client, ctx context.Context) (bool, error) {
+	u := fmt.Sprintf("%s/v1/%s/%s/%s", host, collection, namespace, name)
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, out, http.StatusOK)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get %s: %w", collection, err)
+	}
+	return true, nil
+}
+
+// CreateFleetObject creates an object in a collection of the local cluster.
+func CreateFleetObject(host, token, collection string, in interface{}, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v1/%s", host, collection)
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, in, nil, http.StatusCreated); err != nil {
+		return fmt.Errorf("failed to create %s: %w", collection, err)
+	}
+	return nil
+}
+
+// UpdateFleetObject replaces an object of a collection of the local cluster.
+// The object must carry the resource version it was read with.
+func UpdateFleetObject(host, token, collection, namespace, name string, in interface{}, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v1/%s/%s/%s", host, collection, namespace, name)
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, in, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update %s: %w", collection, err)
+	}
+	return nil
+}
+
+// DeleteFleetObject deletes an object of a collection of the local cluster.
+func DeleteFleetObject(host, token, collection, namespace, name string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v1/%s/%s/%s", host, collection, namespace, name)
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s: %w", collection, err)
+	}
+	return nil
+}
+
+// ApplyFleetSecret creates or replaces a Secret of a Fleet workspace with the
+// type and data of the supplied Secret.
+func ApplyFleetSecret(host, token, namespace, name string, source *corev1.Secret, httpClient http.Client, ctx context.Context) error {
+	secret := fleetv1alpha1.SecretData{}
+	exists, err := GetFleetObject(host, token, secrets, namespace, name, &secret, httpClient, ctx)
+	if err != nil {
+		return err
+	}
+	secret.Type = "secret"
+	secret.Metadata.Name = name
+	secret.Metadata.Namespace = namespace
+	secret.SecretType = string(source.Type)
+	secret.Data = source.Data
+	if !exists {
+		return CreateFleetObject(host, token, secrets, secret, httpClient, ctx)
+	}
+	return UpdateFleetObject(host, token, secrets, namespace, name, secret, httpClient, ctx)
+}
+
+// DeleteFleetSecret deletes a Secret of a Fleet workspace.
+func DeleteFleetSecret(host, token, namespace, name string, httpClient http.Client, ctx context.Context) error {
+	return DeleteFleetObject(host, token, secrets, namespace, name, httpClient, ctx)
+}
@@ -0,0 +1,46 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	hostedv1alpha1 "github.com/dormullor/provider-rancher/apis/hosted/v1alpha1"
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+)
+
+// CreateHostedCluster creates an EKS, AKS or GKE cluster and returns its ID.
+func CreateHostedCluster(host, token string, httpClient http.Client, cluster hostedv1alpha1.HostedClusterRequest, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/clusters", host)
+	result := &v1alpha1.Data{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, cluster, result, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("failed to create cluster: %w", err)
+	}
+	return result.ID, nil
+}
+
+// UpdateHostedCluster replaces the hosted config of an existing cluster.
+func UpdateHostedCluster(host, token, clusterID string, httpClient http.Client, cluster hostedv1alpha1.HostedClusterRequest, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/clusters/%s", host, clusterID)
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, cluster, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update cluster: %w", err)
+	}
+	return nil
+}
+
+// GetCloudCredentialIDByName returns the ID of the Rancher cloud credential
+// with the supplied name.
+func GetCloudCredentialIDByName(host, token, name string, httpClient http.Client, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/cloudcredentials?name=%s", host, url.QueryEscape(name))
+	result := &hostedv1alpha1.CloudCredentialResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return "", fmt.Errorf("failed to get cloud credential: %w", err)
+	}
+	for _, c := range result.Data {
+		if c.Name == name {
+			return c.ID, nil
+		}
+	}
+	return "", fmt.Errorf("cloud credential %q not found", name)
+}
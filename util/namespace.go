@@ -0,0 +1,69 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ProjectIDAnnotation assigns a namespace to a Rancher project.
+	ProjectIDAnnotation = "field.cattle.io/projectId"
+	// ResourceQuotaAnnotation holds the resource quota of a namespace.
+	ResourceQuotaAnnotation = "field.cattle.io/resourceQuota"
+	// ContainerDefaultResourceLimitAnnotation holds the default container
+	// limits of a namespace.
+	ContainerDefaultResourceLimitAnnotation = "field.cattle.io/containerDefaultResourceLimit"
+)
+
+func namespaceURL(host, clusterID string) string {
+	return fmt.Sprintf("%s/k8s/clusters/%s/api/v1/namespaces", host, clusterID)
+}
+
+// GetNamespace returns the namespace with the supplied name from a downstream
+// cluster, or nil if it does not exist.
+func GetNamespace(host, token, clusterID, name string, httpClient http.Client, ctx context.Context) (*corev1.Namespace, error) {
+	ns := &corev1.Namespace{}
+	err := doRequest(ctx, httpClient, http.MethodGet, namespaceURL(host, clusterID)+"/"+name, token, nil, ns, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %w", err)
+	}
+	return ns, nil
+}
+
+// CreateNamespace creates a namespace in a downstream cluster.
+func CreateNamespace(host, token, clusterID string, httpClient http.Client, ns *corev1.Namespace, ctx context.Context) error {
+	if err := doRequest(ctx, httpClient, http.MethodPost, namespaceURL(host, clusterID), token, ns, nil, http.StatusCreated); err != nil {
+		return fmt.Errorf("failed to create namespace: %w", err)
+	}
+	return nil
+}
+
+// PatchNamespaceMetadata merges the supplied labels and annotations into a
+// namespace of a downstream cluster.
+func PatchNamespaceMetadata(host, token, clusterID, name string, labels, annotations map[string]string, httpClient http.Client, ctx context.Context) error {
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels":      labels,
+			"annotations": annotations,
+		},
+	}
+	if err := doRequestWithContentType(ctx, httpClient, http.MethodPatch, namespaceURL(host, clusterID)+"/"+name, token, contentTypeMergePatch, patch, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update namespace: %w", err)
+	}
+	return nil
+}
+
+// DeleteNamespace deletes a namespace from a downstream cluster.
+func DeleteNamespace(host, token, clusterID, name string, httpClient http.Client, ctx context.Context) error {
+	err := doRequest(ctx, httpClient, http.MethodDelete, namespaceURL(host, clusterID)+"/"+name, token, nil, nil)
+	if err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete namespace: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+)
+
+// ListNodes returns the nodes of the cluster with the supplied ID.
+func ListNodes(host, token, clusterID string, httpClient http.Client, ctx context.Context) ([]v1alpha1.NodeData, error) {
+	u := fmt.Sprintf("%s/v3/nodes?clusterId=%s", host, clusterID)
+	result := &v1alpha1.NodeResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	return result.Data, nil
+}
+
+// NodeAction runs an action on the node with the supplied ID.
+func NodeAction(host, token, id, action string, in interface{}, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/nodes/%s?action=%s", host, id, action)
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, in, nil); err != nil {
+		return fmt.Errorf("failed to run node action %s: %w", action, err)
+	}
+	return nil
+}
+
+// CordonNode marks the node with the supplied ID unschedulable.
+func CordonNode(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	return NodeAction(host, token, id, "cordon", nil, httpClient, ctx)
+}
+
+// UncordonNode marks the node with the supplied ID schedulable.
+func UncordonNode(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	return NodeAction(host, token, id, "uncordon", nil, httpClient, ctx)
+}
+
+// DrainNode cordons the node with the supplied ID and evicts its pods.
+func DrainNode(host, token, id string, input v1alpha1.NodeDrainInput, httpClient http.Client, ctx context.Context) error {
+	return NodeAction(host, token, id, "drain", input, httpClient, ctx)
+}
+
+// StopDrainNode stops draining the node with the supplied ID.
+func StopDrainNode(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	return NodeAction(host, token, id, "stopDrain", nil, httpClient, ctx)
+}
+
+// DeleteNode deletes the node with the supplied ID. Rancher replaces a
+// deleted node of a node pool.
+func DeleteNode(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/nodes/%s", host, id)
+	err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil)
+	if err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete node: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/dormullor/provider-rancher/apis/rke1/v1alpha1"
+)
+
+// ListNodePoolsByTemplate returns the node pools that use the node template
+// with the supplied ID.
+func ListNodePoolsByTemplate(host, token, nodeTemplateID string, httpClient http.Client, ctx context.Context) ([]v1alpha1.NodePoolData, error) {
+	q := url.Values{"nodeTemplateId": {nodeTemplateID}}
+	u := fmt.Sprintf("%s/v3/nodepools?%s", host, q.Encode())
+	result := &v1alpha1.NodePoolResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to list node pools: %w", err)
+	}
+	pools := make([]v1alpha1.NodePoolData, 0, len(result.Data))
+	for _, p := range result.Data {
+		if p.NodeTemplateID == nodeTemplateID {
+			pools = append(pools, p)
+		}
+	}
+	return pools, nil
+}
+
+// ListNodePools returns the node pools of the cluster with the supplied ID.
+func ListNodePools(host, token, clusterID string, httpClient http.Client, ctx context.Context) ([]v1alpha1.NodePoolData, error) {
+	q := url.Values{"clusterId": {clusterID}}
+	u := fmt.Sprintf("%s/v3/nodepools?%s", host, q.Encode())
+	result := &v1alpha1.NodePoolResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to list node pools: %w", err)
+	}
+	pools := make([]v1alpha1.NodePoolData, 0, len(result.Data))
+	for _, p := range result.Data {
+		if p.ClusterID == clusterID {
+			pools = append(pools, p)
+		}
+	}
+	return pools, nil
+}
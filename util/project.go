@@ -0,0 +1,59 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	managementv1alpha1 "github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+)
+
+// FindProject returns the project with the supplied name in the supplied
+// cluster from a list of projects, or nil if there is none.
+func FindProject(projects []managementv1alpha1.ProjectData, clusterID, name string) *managementv1alpha1.ProjectData {
+	for i := range projects {
+		if projects[i].Name == name && projects[i].ClusterID == clusterID {
+			return &projects[i]
+		}
+	}
+	return nil
+}
+
+// CreateProject creates a project and returns its ID.
+func CreateProject(host, token string, httpClient http.Client, project managementv1alpha1.ProjectData, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/projects", host)
+	result := &managementv1alpha1.ProjectData{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, project, result, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("failed to create project: %w", err)
+	}
+	return result.ID, nil
+}
+
+// UpdateProject updates the project with the supplied ID.
+func UpdateProject(host, token, projectID string, httpClient http.Client, project managementv1alpha1.ProjectData, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/projects/%s", host, projectID)
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, project, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+	return nil
+}
+
+// SetProjectPodSecurityPolicyTemplate binds a PodSecurityPolicy template to
+// the project. An empty templateID removes the binding.
+func SetProjectPodSecurityPolicyTemplate(host, token, projectID, templateID string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/projects/%s?action=setpodsecuritypolicytemplate", host, projectID)
+	body := map[string]interface{}{"podSecurityPolicyTemplateId": templateID}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, body, nil); err != nil {
+		return fmt.Errorf("failed to set pod security policy template: %w", err)
+	}
+	return nil
+}
+
+// DeleteProject deletes the project with the supplied ID.
+func DeleteProject(host, token, projectID string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/projects/%s", host, projectID)
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+	return nil
+}
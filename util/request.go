@@ -0,0 +1,206 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	contentTypeJSON       = "application/json"
+	contentTypeMergePatch = "application/merge-patch+json"
+)
+
+// An APIError is returned when the Rancher API responds with an unexpected
+// status code.
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	class := "terminal"
+	if e.Retryable() {
+		class = "retryable"
+	}
+	return fmt.Sprintf("%s %s returned %d (%s): %s", e.Method, e.URL, e.StatusCode, class, e.Body)
+}
+
+// retryableStatus lists the status codes of failed requests that may succeed
+// when they are sent again without changes, e.g. after a rate limit or an
+// outage of Rancher. It is true for those Rancher rejects without processing
+// them, which may be sent again whatever their method.
+var retryableStatus = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         false,
+	http.StatusServiceUnavailable: false,
+	http.StatusGatewayTimeout:     false,
+}
+
+// Retryable reports whether the request may succeed when it is sent again
+// later without changes. The transport already resent idempotent requests;
+// others are only sent again once the reconciler observed that Rancher did not
+// process them. Other errors are terminal: they persist until the request
+// changes.
+func (e *APIError) Retryable() bool {
+	_, ok := retryableStatus[e.StatusCode]
+	return ok
+}
+
+// IsRetryable reports whether err may go away when the request is sent again
+// later. Errors that are not APIErrors, such as connection errors, are
+// retryable.
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Retryable()
+	}
+	return err != nil
+}
+
+// IsTerminal reports whether err persists until the request changes, such
+// as a validation error or missing permissions.
+func IsTerminal(err error) bool {
+	return err != nil && !IsRetryable(err)
+}
+
+// IsNotFound reports whether err is an APIError for a 404 response.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// doRequest sends a JSON request to the Rancher API. The response body is
+// decoded into out when out is not nil. An error is returned when the
+// response status is not one of the expected codes.
+func doRequest(ctx context.Context, httpClient http.Client, method, url, token string, in, out interface{}, expected ...int) error {
+	return doRequestWithContentType(ctx, httpClient, method, url, token, contentTypeJSON, in, out, expected...)
+}
+
+func doRequestWithContentType(ctx context.Context, httpClient http.Client, method, url, token, contentType string, in, out interface{}, expected ...int) error {
+	var reqBody io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewBuffer(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", token)
+	req.Header.Add("Accept", "application/json")
+	if in != nil {
+		req.Header.Add("Content-Type", contentType)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer dclose(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if !statusExpected(resp.StatusCode, expected) {
+		return &APIError{Method: method, URL: url, StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, out)
+}
+
+func statusExpected(code int, expected []int) bool {
+	if len(expected) == 0 {
+		return code >= 200 && code < 300
+	}
+	for _, e := range expected {
+		if code == e {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSubset reports whether every field set in desired has the same value in
+// observed. Both values are compared through their JSON representation, so
+// fields omitted from desired are ignored. Slices are compared element by
+// element and must have the same length.
+func IsSubset(desired, observed interface{}) (bool, error) {
+	d, err := toGeneric(desired)
+	if err != nil {
+		return false, err
+	}
+	o, err := toGeneric(observed)
+	if err != nil {
+		return false, err
+	}
+	return isSubset(d, o), nil
+}
+
+func toGeneric(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = json.Unmarshal(b, &out)
+	return out, err
+}
+
+func isSubset(desired, observed interface{}) bool {
+	switch d := desired.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		o, ok := observed.(map[string]interface{})
+		if !ok {
+			return len(d) == 0
+		}
+		for k, v := range d {
+			if !isSubset(v, o[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		o, ok := observed.([]interface{})
+		if !ok {
+			return len(d) == 0
+		}
+		if len(d) != len(o) {
+			return false
+		}
+		for i := range d {
+			if !isSubset(d[i], o[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return desired == observed
+	}
+}
+
+// ListCollection returns the raw JSON response listing all objects of the
+// Rancher v3 collection with the supplied name.
+func ListCollection(host, token, collection string, httpClient http.Client, ctx context.Context) ([]byte, error) {
+	u := fmt.Sprintf("%s/v3/%s?limit=-1", host, collection)
+	var body json.RawMessage
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, &body, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", collection, err)
+	}
+	return body, nil
+}
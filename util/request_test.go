@@ -0,0 +1,82 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsRetryable(t *testing.T) {
+	apiErr := func(code int) error {
+		return fmt.Errorf("failed to create cluster: %w", &APIError{Method: http.MethodPost, URL: "https://rancher.example.org/v3/clusters", StatusCode: code})
+	}
+
+	type want struct {
+		retryable bool
+		terminal  bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   want
+	}{
+		"NoError": {
+			reason: "No error is neither retryable nor terminal.",
+		},
+		"RateLimited": {
+			reason: "Rate limited requests should be retryable.",
+			err:    apiErr(http.StatusTooManyRequests),
+			want:   want{retryable: true},
+		},
+		"Unavailable": {
+			reason: "Requests failing while Rancher is unavailable should be retryable, even those the transport did not resend.",
+			err:    apiErr(http.StatusServiceUnavailable),
+			want:   want{retryable: true},
+		},
+		"Conflict": {
+			reason: "Conflicts persist until the request changes and should be terminal.",
+			err:    apiErr(http.StatusConflict),
+			want:   want{terminal: true},
+		},
+		"InternalServerError": {
+			reason: "Internal server errors are not retried by the transport and should be terminal.",
+			err:    apiErr(http.StatusInternalServerError),
+			want:   want{terminal: true},
+		},
+		"UnprocessableEntity": {
+			reason: "Validation errors should be terminal.",
+			err:    apiErr(http.StatusUnprocessableEntity),
+			want:   want{terminal: true},
+		},
+		"ConnectionError": {
+			reason: "Errors that are not APIErrors should be retryable.",
+			err:    &url.Error{Op: http.MethodPost, URL: "https://rancher.example.org/v3/clusters", Err: errors.New("connection refused")},
+			want:   want{retryable: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{retryable: IsRetryable(tc.err), terminal: IsTerminal(tc.err)}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nIsRetryable(...), IsTerminal(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRetryableConsistency(t *testing.T) {
+	// The transport should only resend requests whose errors are retryable.
+	for code := 100; code < 600; code++ {
+		for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete} {
+			if retryable(method, &http.Response{StatusCode: code}, nil) && !(&APIError{StatusCode: code}).Retryable() {
+				t.Errorf("retryable(%s, %d): resent but classified terminal", method, code)
+			}
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	managementv1alpha1 "github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+)
+
+// Rancher API collections holding role bindings.
+const (
+	ClusterRoleTemplateBindings = "clusterroletemplatebindings"
+	ProjectRoleTemplateBindings = "projectroletemplatebindings"
+	GlobalRoleBindings          = "globalrolebindings"
+)
+
+// GetRoleBinding returns the binding with the supplied ID from the supplied
+// collection, or nil if it does not exist.
+func GetRoleBinding(host, token, collection, id string, httpClient http.Client, ctx context.Context) (*managementv1alpha1.RoleBindingData, error) {
+	u := fmt.Sprintf("%s/v3/%s/%s", host, collection, id)
+	result := &managementv1alpha1.RoleBindingData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role binding: %w", err)
+	}
+	return result, nil
+}
+
+// ListRoleBindings returns the bindings of the supplied collection that match
+// every field set in filter.
+func ListRoleBindings(host, token, collection string, filter managementv1alpha1.RoleBindingData, httpClient http.Client, ctx context.Context) ([]managementv1alpha1.RoleBindingData, error) {
+	q := url.Values{}
+	for k, v := range map[string]string{
+		"clusterId":        filter.ClusterID,
+		"projectId":        filter.ProjectID,
+		"roleTemplateId":   filter.RoleTemplateID,
+		"globalRoleId":     filter.GlobalRoleID,
+		"userId":           filter.UserID,
+		"groupPrincipalId": filter.GroupPrincipalID,
+	} {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	u := fmt.Sprintf("%s/v3/%s?%s", host, collection, q.Encode())
+	result := &managementv1alpha1.RoleBindingResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to list role bindings: %w", err)
+	}
+	return MatchRoleBindings(result.Data, filter)
+}
+
+// MatchRoleBindings returns the bindings that match every field set in filter.
+func MatchRoleBindings(bindings []managementv1alpha1.RoleBindingData, filter managementv1alpha1.RoleBindingData) ([]managementv1alpha1.RoleBindingData, error) {
+	matches := []managementv1alpha1.RoleBindingData{}
+	for _, b := range bindings {
+		match, err := IsSubset(filter, b)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			matches = append(matches, b)
+		}
+	}
+	return matches, nil
+}
+
+// CreateRoleBinding creates a binding in the supplied collection and returns
+// its ID.
+func CreateRoleBinding(host, token, collection string, httpClient http.Client, binding managementv1alpha1.RoleBindingData, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/%s", host, collection)
+	result := &managementv1alpha1.RoleBindingData{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, binding, result, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("failed to create role binding: %w", err)
+	}
+	return result.ID, nil
+}
+
+// DeleteRoleBinding deletes the binding with the supplied ID. Bindings that
+// no longer exist are ignored.
+func DeleteRoleBinding(host, token, collection, id string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/%s/%s", host, collection, id)
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete role binding: %w", err)
+	}
+	return nil
+}
+
+// ResolveSubject returns a binding with the subject fields set from the
+// supplied Subject, looking up user and group names where necessary.
+func ResolveSubject(host, token string, subject managementv1alpha1.Subject, httpClient http.Client, ctx context.Context) (managementv1alpha1.RoleBindingData, error) {
+	b := managementv1alpha1.RoleBindingData{
+		UserID:           subject.UserID,
+		UserPrincipalID:  subject.UserPrincipalID,
+		GroupPrincipalID: subject.GroupPrincipalID,
+	}
+	if subject.UserName != "" {
+		id, err := GetUserIDByUsername(host, token, subject.UserName, httpClient, ctx)
+		if err != nil {
+			return b, err
+		}
+		b.UserID = id
+	}
+	if subject.GroupName != "" {
+		id, err := SearchGroupPrincipalID(host, token, subject.GroupName, httpClient, ctx)
+		if err != nil {
+			return b, err
+		}
+		b.GroupPrincipalID = id
+	}
+	if b.UserID == "" && b.UserPrincipalID == "" && b.GroupPrincipalID == "" {
+		return b, fmt.Errorf("binding subject must set a user or group")
+	}
+	return b, nil
+}
+
+// GetUserIDByUsername returns the ID of the Rancher user with the supplied
+// username.
+func GetUserIDByUsername(host, token, username string, httpClient http.Client, ctx context.Context) (string, error) {
+	user, err := GetUserByUsername(host, token, username, httpClient, ctx)
+	if err != nil {
+		return "", err
+	}
+	if user == nil {
+		return "", fmt.Errorf("user %q not found", username)
+	}
+	return user.ID, nil
+}
+
+// SearchGroupPrincipalID searches the active authentication providers for a
+// group with the supplied name and returns its principal ID.
+func SearchGroupPrincipalID(host, token, name string, httpClient http.Client, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/principals?action=search", host)
+	body := map[string]string{"name": name, "principalType": "group"}
+	result := &managementv1alpha1.PrincipalResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, body, result, http.StatusOK); err != nil {
+		return "", fmt.Errorf("failed to search principals: %w", err)
+	}
+	for _, p := range result.Data {
+		if p.PrincipalType == "group" && (p.Name == name || p.LoginName == name) {
+			return p.ID, nil
+		}
+	}
+	return "", fmt.Errorf("group %q not found", name)
+}
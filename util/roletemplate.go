@@ -0,0 +1,128 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	managementv1alpha1 "github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+)
+
+// GetRoleTemplate returns the role template with the supplied ID, or nil if
+// it does not exist.
+func GetRoleTemplate(host, token, id string, httpClient http.Client, ctx context.Context) (*managementv1alpha1.RoleTemplateData, error) {
+	u := fmt.Sprintf("%s/v3/roletemplates/%s", host, id)
+	result := &managementv1alpha1.RoleTemplateData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role template: %w", err)
+	}
+	return result, nil
+}
+
+// GetRoleTemplateByName returns the role template with the supplied name, or
+// nil if it does not exist.
+func GetRoleTemplateByName(host, token, name string, httpClient http.Client, ctx context.Context) (*managementv1alpha1.RoleTemplateData, error) {
+	u := fmt.Sprintf("%s/v3/roletemplates?name=%s", host, url.QueryEscape(name))
+	result := &managementv1alpha1.RoleTemplateResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to get role template: %w", err)
+	}
+	for i := range result.Data {
+		if result.Data[i].Name == name {
+			return &result.Data[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateRoleTemplate creates a role template and returns its ID.
+func CreateRoleTemplate(host, token string, httpClient http.Client, rt managementv1alpha1.RoleTemplateData, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/roletemplates", host)
+	result := &managementv1alpha1.RoleTemplateData{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, rt, result, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("failed to create role template: %w", err)
+	}
+	return result.ID, nil
+}
+
+// UpdateRoleTemplate updates the role template with the supplied ID.
+func UpdateRoleTemplate(host, token, id string, httpClient http.Client, rt managementv1alpha1.RoleTemplateData, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/roletemplates/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, rt, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update role template: %w", err)
+	}
+	return nil
+}
+
+// DeleteRoleTemplate deletes the role template with the supplied ID.
+func DeleteRoleTemplate(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/roletemplates/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete role template: %w", err)
+	}
+	return nil
+}
+
+// GetGlobalRole returns the global role with the supplied ID, or nil if it
+// does not exist.
+func GetGlobalRole(host, token, id string, httpClient http.Client, ctx context.Context) (*managementv1alpha1.GlobalRoleData, error) {
+	u := fmt.Sprintf("%s/v3/globalroles/%s", host, id)
+	result := &managementv1alpha1.GlobalRoleData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get global role: %w", err)
+	}
+	return result, nil
+}
+
+// GetGlobalRoleByName returns the global role with the supplied name, or nil
+// if it does not exist.
+func GetGlobalRoleByName(host, token, name string, httpClient http.Client, ctx context.Context) (*managementv1alpha1.GlobalRoleData, error) {
+	u := fmt.Sprintf("%s/v3/globalroles?name=%s", host, url.QueryEscape(name))
+	result := &managementv1alpha1.GlobalRoleResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to get global role: %w", err)
+	}
+	for i := range result.Data {
+		if result.Data[i].Name == name {
+			return &result.Data[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateGlobalRole creates a global role and returns its ID.
+func CreateGlobalRole(host, token string, httpClient http.Client, gr managementv1alpha1.GlobalRoleData, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/globalroles", host)
+	result := &managementv1alpha1.GlobalRoleData{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, gr, result, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("failed to create global role: %w", err)
+	}
+	return result.ID, nil
+}
+
+// UpdateGlobalRole updates the global role with the supplied ID.
+func UpdateGlobalRole(host, token, id string, httpClient http.Client, gr managementv1alpha1.GlobalRoleData, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/globalroles/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, gr, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update global role: %w", err)
+	}
+	return nil
+}
+
+// DeleteGlobalRole deletes the global role with the supplied ID.
+func DeleteGlobalRole(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/globalroles/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete global role: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetSecretValue returns the value of the selected key of a Kubernetes Secret
+// together with the resource version of the Secret.
+func GetSecretValue(ctx context.Context, kubeClient client.Client, selector xpv1.SecretKeySelector) ([]byte, string, error) {
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, client.ObjectKey{Name: selector.Name, Namespace: selector.Namespace}, secret); err != nil {
+		return nil, "", err
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return nil, "", fmt.Errorf("secret %s/%s has no key %q", selector.Namespace, selector.Name, selector.Key)
+	}
+	return value, secret.ResourceVersion, nil
+}
+
+// GetSecretValues returns the values of the selected Secret keys together with
+// a version that changes whenever one of the Secrets changes.
+func GetSecretValues(ctx context.Context, kubeClient client.Client, selectors ...xpv1.SecretKeySelector) ([][]byte, string, error) {
+	values := make([][]byte, 0, len(selectors))
+	versions := make([]string, 0, len(selectors))
+	for _, s := range selectors {
+		value, version, err := GetSecretValue(ctx, kubeClient, s)
+		if err != nil {
+			return nil, "", err
+		}
+		values = append(values, value)
+		versions = append(versions, version)
+	}
+	return values, strings.Join(versions, ","), nil
+}
+
+// GetSecret returns the referenced Kubernetes Secret.
+func GetSecret(ctx context.Context, kubeClient client.Client, ref xpv1.SecretReference) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
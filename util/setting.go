@@ -0,0 +1,71 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	managementv1alpha1 "github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+)
+
+// GetSetting returns the setting with the supplied name, or nil if it does
+// not exist.
+func GetSetting(host, token, name string, httpClient http.Client, ctx context.Context) (*managementv1alpha1.SettingData, error) {
+	u := fmt.Sprintf("%s/v3/settings/%s", host, name)
+	result := &managementv1alpha1.SettingData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get setting: %w", err)
+	}
+	return result, nil
+}
+
+// CreateSetting creates a setting that is not known to Rancher.
+func CreateSetting(host, token, name, value string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/settings", host)
+	setting := managementv1alpha1.SettingData{Name: name, Value: value}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, setting, nil, http.StatusCreated); err != nil {
+		return fmt.Errorf("failed to create setting: %w", err)
+	}
+	return nil
+}
+
+// UpdateSetting sets the value of the setting with the supplied name. An
+// empty value resets the setting to its default.
+func UpdateSetting(host, token, name, value string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/settings/%s", host, name)
+	setting := managementv1alpha1.SettingData{Name: name, Value: value}
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, setting, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update setting: %w", err)
+	}
+	return nil
+}
+
+// GetFeature returns the feature flag with the supplied name, or nil if it
+// does not exist.
+func GetFeature(host, token, name string, httpClient http.Client, ctx context.Context) (*managementv1alpha1.FeatureData, error) {
+	u := fmt.Sprintf("%s/v3/features/%s", host, name)
+	result := &managementv1alpha1.FeatureData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature: %w", err)
+	}
+	return result, nil
+}
+
+// UpdateFeature sets the value of the feature flag with the supplied name. A
+// nil value resets the flag to its default.
+func UpdateFeature(host, token, name string, value *bool, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/features/%s", host, name)
+	feature := managementv1alpha1.FeatureData{Name: name, Value: value}
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, feature, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update feature: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	managementv1alpha1 "github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+)
+
+// GetToken returns the API token with the supplied ID, or nil if it does not
+// exist.
+func GetToken(host, token, id string, httpClient http.Client, ctx context.Context) (*managementv1alpha1.TokenData, error) {
+	u := fmt.Sprintf("%s/v3/tokens/%s", host, id)
+	result := &managementv1alpha1.TokenData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+	return result, nil
+}
+
+// CreateToken creates an API token. The returned token holds the secret value,
+// which Rancher does not return again.
+func CreateToken(host, token string, httpClient http.Client, t managementv1alpha1.TokenData, ctx context.Context) (*managementv1alpha1.TokenData, error) {
+	u := fmt.Sprintf("%s/v3/tokens", host)
+	result := &managementv1alpha1.TokenData{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, t, result, http.StatusCreated); err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+	return result, nil
+}
+
+// DeleteToken deletes the API token with the supplied ID.
+func DeleteToken(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/tokens/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete token: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package util
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+	"github.com/dormullor/provider-rancher/internal/metrics"
+)
+
+// Retries of failed requests. The delay before a retry doubles with every
+// attempt, up to maxRetryDelay, unless Rancher asks for a delay with
+// Retry-After.
+const (
+	maxRetries     = 5
+	baseRetryDelay = 500 * time.Millisecond
+	maxRetryDelay  = 30 * time.Second
+)
+
+var (
+	limitersMu sync.Mutex
+	limiters   = map[string]*rate.Limiter{}
+)
+
+// NewHTTPClient returns a client for the Rancher host of the ProviderConfig.
+// It retries requests that failed with a retryable error and limits the rate
+// of requests to the host as configured by the ProviderConfig.
+func NewHTTPClient(pc *apisv1alpha1.ProviderConfig) *http.Client {
+	return &http.Client{Transport: &transport{next: http.DefaultTransport, limiter: hostLimiter(pc)}}
+}
+
+// hostLimiter returns the rate limiter shared by the requests to the Rancher
+// host of the ProviderConfig, updated to its rate limit, or nil if it does
+// not configure one.
+func hostLimiter(pc *apisv1alpha1.ProviderConfig) *rate.Limiter {
+	rl := pc.Spec.RateLimit
+	if rl == nil {
+		return nil
+	}
+	host := pc.Spec.RancherHost
+	if u, err := url.Parse(host); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	burst := int(rl.Burst)
+	if burst == 0 {
+		burst = int(rl.QPS)
+	}
+
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+	l, ok := limiters[host]
+	if !ok {
+		l = rate.NewLimiter(rate.Limit(rl.QPS), burst)
+		limiters[host] = l
+		return l
+	}
+	if l.Limit() != rate.Limit(rl.QPS) {
+		l.SetLimit(rate.Limit(rl.QPS))
+	}
+	if l.Burst() != burst {
+		l.SetBurst(burst)
+	}
+	return l
+}
+
+// A transport limits and retries requests to Rancher.
+type transport struct {
+	next    http.RoundTripper
+	limiter *rate.Limiter
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		r := req
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		start := time.Now()
+		resp, err := t.next.RoundTrip(r)
+		code := 0
+		if resp != nil {
+			code = resp.StatusCode
+		}
+		metrics.ObserveRequest(req.Method, endpoint(req.URL), code, time.Since(start))
+
+		canRetry := req.Body == nil || req.GetBody != nil
+		if attempt >= maxRetries || !canRetry || ctx.Err() != nil || !retryable(req.Method, resp, err) {
+			return resp, err
+		}
+		delay := retryDelay(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			dclose(resp.Body)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// idempotent reports whether a request with the supplied method may be sent
+// again when it is unknown whether Rancher processed it.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryable reports whether a request should be sent again right away after
+// it failed with the supplied response or error. Only the failures listed in
+// retryableStatus are retried, and those Rancher may have processed only for
+// idempotent requests.
+func retryable(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return idempotent(method)
+	}
+	unprocessed, ok := retryableStatus[resp.StatusCode]
+	return ok && (unprocessed || idempotent(method))
+}
+
+// retryDelay returns how long to wait before retrying a request: the delay
+// requested by the Retry-After header of the response if any, and otherwise
+// an exponential backoff with jitter.
+func retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if d > maxRetryDelay {
+				return maxRetryDelay
+			}
+			return d
+		}
+	}
+	d := baseRetryDelay << attempt
+	if d > maxRetryDelay {
+		d = maxRetryDelay
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1)) // nolint:gosec // Jitter needs no secure randomness.
+}
+
+// retryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// endpoint returns the path of a request to Rancher with the identifiers of
+// the objects it addresses replaced by {id}, e.g. /v3/clusters/{id} or
+// /k8s/clusters/{id}/api/v1/pods. Actions are kept, since they distinguish
+// otherwise identical endpoints.
+func endpoint(u *url.URL) string {
+	path := strings.Trim(u.Path, "/")
+	prefix := ""
+	if rest := strings.TrimPrefix(path, "k8s/clusters/"); rest != path {
+		prefix = "/k8s/clusters/{id}"
+		_, path, _ = strings.Cut(rest, "/")
+	}
+	// Paths consist of an API version, e.g. v3 or api/v1, and a collection
+	// followed by identifiers.
+	segments := strings.Split(path, "/")
+	n := 2
+	if segments[0] == "api" {
+		n = 3
+	}
+	for i := n; i < len(segments); i++ {
+		segments[i] = "{id}"
+	}
+	e := prefix + "/" + strings.Join(segments, "/")
+	if action := u.Query().Get("action"); action != "" {
+		e += "?action=" + action
+	}
+	return e
+}
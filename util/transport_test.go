@@ -0,0 +1,291 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	apisv1alpha1 "github.com/dormullor/provider-rancher/apis/v1alpha1"
+)
+
+var errConnection = errors.New("connection refused")
+
+// A fakeRoundTripper returns its responses in order, repeating the last one,
+// and records the bodies of the requests it receives.
+type fakeRoundTripper struct {
+	responses []fakeResponse
+	bodies    []string
+}
+
+type fakeResponse struct {
+	code int
+	err  error
+}
+
+func (f *fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body)
+		body = string(b)
+	}
+	r := f.responses[len(f.responses)-1]
+	if len(f.bodies) < len(f.responses) {
+		r = f.responses[len(f.bodies)]
+	}
+	f.bodies = append(f.bodies, body)
+	if r.err != nil {
+		return nil, r.err
+	}
+	// A Retry-After of 0 keeps the tests from waiting for the backoff.
+	return &http.Response{
+		StatusCode: r.code,
+		Header:     http.Header{"Retry-After": []string{"0"}},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}, nil
+}
+
+func TestTransportRoundTrip(t *testing.T) {
+	type want struct {
+		code   int
+		err    error
+		bodies []string
+	}
+
+	cases := map[string]struct {
+		reason    string
+		method    string
+		body      string
+		responses []fakeResponse
+		want      want
+	}{
+		"Success": {
+			reason:    "Successful requests should be sent once.",
+			method:    http.MethodGet,
+			responses: []fakeResponse{{code: http.StatusOK}},
+			want:      want{code: http.StatusOK, bodies: []string{""}},
+		},
+		"IdempotentUnavailable": {
+			reason:    "Idempotent requests should be retried while Rancher is unavailable.",
+			method:    http.MethodGet,
+			responses: []fakeResponse{{code: http.StatusServiceUnavailable}, {code: http.StatusBadGateway}, {code: http.StatusOK}},
+			want:      want{code: http.StatusOK, bodies: []string{"", "", ""}},
+		},
+		"NonIdempotentUnavailable": {
+			reason:    "Requests that are not idempotent may have been processed and should not be retried.",
+			method:    http.MethodPost,
+			body:      "{}",
+			responses: []fakeResponse{{code: http.StatusServiceUnavailable}, {code: http.StatusCreated}},
+			want:      want{code: http.StatusServiceUnavailable, bodies: []string{"{}"}},
+		},
+		"NonIdempotentRateLimited": {
+			reason:    "Rate limited requests were not processed and should be retried with their body whatever their method.",
+			method:    http.MethodPost,
+			body:      "{}",
+			responses: []fakeResponse{{code: http.StatusTooManyRequests}, {code: http.StatusCreated}},
+			want:      want{code: http.StatusCreated, bodies: []string{"{}", "{}"}},
+		},
+		"IdempotentConnectionError": {
+			reason:    "Idempotent requests should be retried after connection errors.",
+			method:    http.MethodDelete,
+			responses: []fakeResponse{{err: errConnection}, {code: http.StatusOK}},
+			want:      want{code: http.StatusOK, bodies: []string{"", ""}},
+		},
+		"NonIdempotentConnectionError": {
+			reason:    "Requests that are not idempotent should not be retried after connection errors.",
+			method:    http.MethodPost,
+			body:      "{}",
+			responses: []fakeResponse{{err: errConnection}, {code: http.StatusCreated}},
+			want:      want{err: errConnection, bodies: []string{"{}"}},
+		},
+		"TerminalError": {
+			reason:    "Errors that persist until the request changes should not be retried.",
+			method:    http.MethodGet,
+			responses: []fakeResponse{{code: http.StatusNotFound}, {code: http.StatusOK}},
+			want:      want{code: http.StatusNotFound, bodies: []string{""}},
+		},
+		"RetriesExhausted": {
+			reason:    "Requests should be retried at most maxRetries times.",
+			method:    http.MethodGet,
+			responses: []fakeResponse{{code: http.StatusServiceUnavailable}},
+			want:      want{code: http.StatusServiceUnavailable, bodies: []string{"", "", "", "", "", ""}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			next := &fakeRoundTripper{responses: tc.responses}
+			var body io.Reader
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			req, err := http.NewRequestWithContext(context.Background(), tc.method, "https://rancher.example.org/v3/clusters", body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := (&transport{next: next}).RoundTrip(req)
+			code := 0
+			if resp != nil {
+				code = resp.StatusCode
+			}
+			if diff := cmp.Diff(tc.want.err, err, equateErrors()); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.code, code); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want status code, +got status code:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.bodies, next.bodies); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want request bodies, +got request bodies:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	type want struct {
+		min time.Duration
+		max time.Duration
+	}
+
+	cases := map[string]struct {
+		reason     string
+		attempt    int
+		retryAfter string
+		want       want
+	}{
+		"RetryAfterSeconds": {
+			reason:     "The delay requested by Rancher should be honoured.",
+			retryAfter: "7",
+			want:       want{min: 7 * time.Second, max: 7 * time.Second},
+		},
+		"RetryAfterTooLong": {
+			reason:     "Requested delays should not exceed maxRetryDelay.",
+			retryAfter: "3600",
+			want:       want{min: maxRetryDelay, max: maxRetryDelay},
+		},
+		"FirstAttempt": {
+			reason: "The first retry should wait for up to baseRetryDelay.",
+			want:   want{min: baseRetryDelay / 2, max: baseRetryDelay},
+		},
+		"Backoff": {
+			reason:  "The delay should double with every attempt.",
+			attempt: 3,
+			want:    want{min: 4 * baseRetryDelay, max: 8 * baseRetryDelay},
+		},
+		"InvalidRetryAfter": {
+			reason:     "Retry-After headers that cannot be parsed should be ignored.",
+			attempt:    3,
+			retryAfter: "soon",
+			want:       want{min: 4 * baseRetryDelay, max: 8 * baseRetryDelay},
+		},
+		"BackoffTooLong": {
+			reason:  "The backoff should not exceed maxRetryDelay.",
+			attempt: 10,
+			want:    want{min: maxRetryDelay / 2, max: maxRetryDelay},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tc.retryAfter != "" {
+				resp.Header.Set("Retry-After", tc.retryAfter)
+			}
+			got := retryDelay(tc.attempt, resp)
+			if got < tc.want.min || got > tc.want.max {
+				t.Errorf("\n%s\nretryDelay(%d, ...): want delay in [%s, %s], got %s\n", tc.reason, tc.attempt, tc.want.min, tc.want.max, got)
+			}
+		})
+	}
+}
+
+func TestHostLimiter(t *testing.T) {
+	pc := func(host string, rl *apisv1alpha1.RateLimit) *apisv1alpha1.ProviderConfig {
+		return &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{RancherHost: host, RateLimit: rl}}
+	}
+
+	if l := hostLimiter(pc("https://unlimited.example.org", nil)); l != nil {
+		t.Errorf("hostLimiter(...): want no limiter without a rate limit, got %v", l)
+	}
+
+	a := hostLimiter(pc("https://limited.example.org", &apisv1alpha1.RateLimit{QPS: 5}))
+	if a.Burst() != 5 {
+		t.Errorf("hostLimiter(...): want burst to default to QPS 5, got %d", a.Burst())
+	}
+
+	b := hostLimiter(pc("https://limited.example.org/", &apisv1alpha1.RateLimit{QPS: 10, Burst: 20}))
+	if a != b {
+		t.Errorf("hostLimiter(...): want requests to the same host to share a limiter")
+	}
+	if b.Limit() != 10 || b.Burst() != 20 {
+		t.Errorf("hostLimiter(...): want the shared limiter updated to QPS 10 and burst 20, got %v and %d", b.Limit(), b.Burst())
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		url    string
+		want   string
+	}{
+		"Collection": {
+			reason: "Collections should be kept.",
+			url:    "https://rancher.example.org/v3/clusters?name=cool",
+			want:   "/v3/clusters",
+		},
+		"Object": {
+			reason: "Object IDs should be replaced.",
+			url:    "https://rancher.example.org/v3/clusters/c-m-abc123",
+			want:   "/v3/clusters/{id}",
+		},
+		"Action": {
+			reason: "Actions should be kept, since they distinguish otherwise identical endpoints.",
+			url:    "https://rancher.example.org/v3/clusters/c-m-abc123?action=generateKubeconfig",
+			want:   "/v3/clusters/{id}?action=generateKubeconfig",
+		},
+		"CoreAPI": {
+			reason: "Paths of the core Kubernetes API have a three segment prefix.",
+			url:    "https://rancher.example.org/api/v1/namespaces/cattle-system",
+			want:   "/api/v1/namespaces/{id}",
+		},
+		"ClusterProxy": {
+			reason: "The cluster ID of requests proxied to a downstream cluster should be replaced.",
+			url:    "https://rancher.example.org/k8s/clusters/c-m-abc123/v1/catalog.cattle.io.apps/cattle-system/rancher",
+			want:   "/k8s/clusters/{id}/v1/catalog.cattle.io.apps/{id}/{id}",
+		},
+		"ClusterProxyCoreAPI": {
+			reason: "Core API paths proxied to a downstream cluster should keep their three segment prefix.",
+			url:    "https://rancher.example.org/k8s/clusters/local/api/v1/pods",
+			want:   "/k8s/clusters/{id}/api/v1/pods",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u, err := url.Parse(tc.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, endpoint(u)); diff != "" {
+				t.Errorf("\n%s\nendpoint(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// equateErrors considers errors equal when their messages are.
+func equateErrors() cmp.Option {
+	return cmp.Comparer(func(a, b error) bool {
+		if a == nil || b == nil {
+			return a == nil && b == nil
+		}
+		return a.Error() == b.Error()
+	})
+}
@@ -0,0 +1,80 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	managementv1alpha1 "github.com/dormullor/provider-rancher/apis/management/v1alpha1"
+)
+
+// GetUser returns the user with the supplied ID, or nil if it does not exist.
+func GetUser(host, token, id string, httpClient http.Client, ctx context.Context) (*managementv1alpha1.UserData, error) {
+	u := fmt.Sprintf("%s/v3/users/%s", host, id)
+	result := &managementv1alpha1.UserData{}
+	err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return result, nil
+}
+
+// GetUserByUsername returns the user with the supplied username, or nil if it
+// does not exist.
+func GetUserByUsername(host, token, username string, httpClient http.Client, ctx context.Context) (*managementv1alpha1.UserData, error) {
+	u := fmt.Sprintf("%s/v3/users?username=%s", host, url.QueryEscape(username))
+	result := &managementv1alpha1.UserResponse{}
+	if err := doRequest(ctx, httpClient, http.MethodGet, u, token, nil, result, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	for i := range result.Data {
+		if result.Data[i].Username == username {
+			return &result.Data[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateUser creates a user and returns its ID.
+func CreateUser(host, token string, httpClient http.Client, user managementv1alpha1.UserData, ctx context.Context) (string, error) {
+	u := fmt.Sprintf("%s/v3/users", host)
+	result := &managementv1alpha1.UserData{}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, user, result, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("failed to create user: %w", err)
+	}
+	return result.ID, nil
+}
+
+// UpdateUser updates the user with the supplied ID. The password is not
+// changed, see SetUserPassword.
+func UpdateUser(host, token, id string, httpClient http.Client, user managementv1alpha1.UserData, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/users/%s", host, id)
+	user.Password = ""
+	if err := doRequest(ctx, httpClient, http.MethodPut, u, token, user, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+	return nil
+}
+
+// SetUserPassword sets the password of the user with the supplied ID.
+func SetUserPassword(host, token, id, password string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/users/%s?action=setpassword", host, id)
+	body := map[string]string{"newPassword": password}
+	if err := doRequest(ctx, httpClient, http.MethodPost, u, token, body, nil); err != nil {
+		return fmt.Errorf("failed to set user password: %w", err)
+	}
+	return nil
+}
+
+// DeleteUser deletes the user with the supplied ID.
+func DeleteUser(host, token, id string, httpClient http.Client, ctx context.Context) error {
+	u := fmt.Sprintf("%s/v3/users/%s", host, id)
+	if err := doRequest(ctx, httpClient, http.MethodDelete, u, token, nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	return nil
+}
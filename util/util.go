@@ -8,6 +8,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -19,6 +20,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// KubeconfigGeneratedAnnotation records on a kubeconfig secret when its
+// kubeconfig was generated.
+const KubeconfigGeneratedAnnotation = "rancher.crossplane.io/kubeconfig-generated"
+
 func GenerateKubeconfigSecret(kubeconfig []byte, clusterName string, namespace string) corev1.Secret {
 	if namespace == "" {
 		namespace = "default"
@@ -27,6 +32,9 @@ func GenerateKubeconfigSecret(kubeconfig []byte, clusterName string, namespace s
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-kubeconfig", clusterName),
 			Namespace: namespace,
+			Annotations: map[string]string{
+				KubeconfigGeneratedAnnotation: time.Now().UTC().Format(time.RFC3339),
+			},
 		},
 		Data: map[string][]byte{
 			"kubeconfig": kubeconfig,
@@ -44,45 +52,69 @@ func CreateKubeconfigSecret(ctx context.Context, kubeconfig []byte, clusterName
 }
 
 func KubeconfigSecretExist(ctx context.Context, clusterName string, namespace string, kubeClient client.Client) bool {
+	if namespace == "" {
+		namespace = "default"
+	}
 	err := kubeClient.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("%s-kubeconfig", clusterName), Namespace: namespace}, &corev1.Secret{})
 	return err == nil
 }
 
+// KubeconfigGenerated returns when the kubeconfig of the cluster was last
+// generated. Secrets written before the time was recorded report their
+// creation time.
+func KubeconfigGenerated(ctx context.Context, clusterName string, namespace string, kubeClient client.Client) (time.Time, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+	secret := &corev1.Secret{}
+	if err := kubeClient.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("%s-kubeconfig", clusterName), Namespace: namespace}, secret); err != nil {
+		return time.Time{}, fmt.Errorf("failed to get kubeconfig secret: %w", err)
+	}
+	if t, err := time.Parse(time.RFC3339, secret.Annotations[KubeconfigGeneratedAnnotation]); err == nil {
+		return t, nil
+	}
+	return secret.CreationTimestamp.Time, nil
+}
+
 func GenerateKubeconfig(ctx context.Context, host, clusterID, token, crName, crNamespace string, httpClient http.Client, client client.Client) error {
 	exist := KubeconfigSecretExist(ctx, crName, crNamespace, client)
 	if !exist {
-		url := fmt.Sprintf("%s/v3/clusters/%s?action=generateKubeconfig", host, clusterID)
-		req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
-		if err != nil {
-			return err
-		}
-		req.Header.Add("Authorization", token)
-		req.Header.Add("Accept", "application/json")
-		resp, err := httpClient.Do(req)
-		if err != nil {
-			return err
-		}
-		defer dclose(resp.Body)
-
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return err
-		}
-		var result *v1alpha1.KubeconfigResponse
-		if err := json.Unmarshal(body, &result); err != nil {
-			fmt.Println("Can not unmarshal JSON")
-		}
-		if resp.StatusCode != 200 {
-			return fmt.Errorf("failed to generate kubeconfig: %s", string(body))
-		}
-		err = CreateKubeconfigSecret(ctx, []byte(result.Config), crName, crNamespace, client)
-		if err != nil {
-			return err
-		}
+		return RefreshKubeconfig(ctx, host, clusterID, token, crName, crNamespace, httpClient, client)
 	}
 	return nil
 }
 
+// RefreshKubeconfig generates a new kubeconfig for the cluster and writes it
+// to the kubeconfig secret, whether or not the secret exists.
+func RefreshKubeconfig(ctx context.Context, host, clusterID, token, crName, crNamespace string, httpClient http.Client, client client.Client) error {
+	url := fmt.Sprintf("%s/v3/clusters/%s?action=generateKubeconfig", host, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", token)
+	req.Header.Add("Accept", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer dclose(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("failed to generate kubeconfig: %s", string(body))
+	}
+
+	var result *v1alpha1.KubeconfigResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("failed to generate kubeconfig: %w", err)
+	}
+	return CreateKubeconfigSecret(ctx, []byte(result.Config), crName, crNamespace, client)
+}
+
 func GetClusters(host, token string, httpClient http.Client, ctx context.Context) (v1alpha1.ClusterResponse, error) {
 	url := fmt.Sprintf("%s/v3/clusters", host)
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
@@ -103,14 +135,15 @@ func GetClusters(host, token string, httpClient http.Client, ctx context.Context
 		return v1alpha1.ClusterResponse{}, err
 	}
 
-	var result *v1alpha1.ClusterResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		fmt.Println("Can not unmarshal JSON")
-	}
 	if resp.StatusCode != 200 {
 		return v1alpha1.ClusterResponse{}, fmt.Errorf("failed to get clusters: %s", string(body))
 	}
 
+	var result *v1alpha1.ClusterResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return v1alpha1.ClusterResponse{}, fmt.Errorf("failed to get clusters: %w", err)
+	}
+
 	return *result, nil
 }
 
@@ -138,14 +171,15 @@ func CreateCluster(host, token string, httpClient http.Client, cluster *v1alpha1
 		return "", err
 	}
 
-	var result *v1alpha1.Data
-	if err := json.Unmarshal(body, &result); err != nil {
-		fmt.Println("Can not unmarshal JSON")
-	}
 	if resp.StatusCode != 201 {
 		return "", fmt.Errorf("failed to create cluster: %s", string(body))
 	}
 
+	var result *v1alpha1.Data
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to create cluster: %w", err)
+	}
+
 	return result.ID, nil
 }
 
@@ -236,20 +270,20 @@ func CreateNodeTemplate(host, token string, httpClient http.Client, nodeTemplate
 		return "", err
 	}
 
-	var result *v1alpha1.Data
-	if err := json.Unmarshal(body, &result); err != nil {
-		fmt.Println("Can not unmarshal JSON")
-	}
 	if resp.StatusCode != 201 {
 		return "", fmt.Errorf("failed to create node template: %s", string(body))
 	}
 
+	var result *v1alpha1.Data
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to create node template: %w", err)
+	}
+
 	return result.ID, nil
 }
 
 func DeleteNodeTemplate(host, token, nodeTemplateID string, httpClient http.Client, ctx context.Context) error {
 	url := fmt.Sprintf("%s/v3/nodetemplates/%s", host, nodeTemplateID)
-	fmt.Println(url)
 	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
@@ -294,14 +328,15 @@ func GetNodeTemplates(host, token string, httpClient http.Client, ctx context.Co
 		return v1alpha1.ClusterResponse{}, err
 	}
 
-	var result *v1alpha1.ClusterResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		fmt.Println("Can not unmarshal JSON")
-	}
 	if resp.StatusCode != 200 {
 		return v1alpha1.ClusterResponse{}, fmt.Errorf("failed to get node templates: %s", string(body))
 	}
 
+	var result *v1alpha1.ClusterResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return v1alpha1.ClusterResponse{}, fmt.Errorf("failed to get node templates: %w", err)
+	}
+
 	return *result, nil
 }
 
@@ -388,13 +423,14 @@ func GetNodeTemplateByName(host, token, name string, httpClient http.Client, ctx
 		return "", err
 	}
 
-	var result *v1alpha1.ClusterResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		fmt.Println("Can not unmarshal JSON")
-	}
 	if resp.StatusCode != 200 {
 		return "", fmt.Errorf("failed to get node template: %s", string(body))
 	}
 
+	var result *v1alpha1.ClusterResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to get node template: %w", err)
+	}
+
 	return result.Data[0].ID, nil
 }